@@ -1,7 +1,17 @@
 package configs
 
 import (
+	"fmt"
 	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultPlacementTimeout = 5 * time.Minute
+	defaultDeliveryAttempts = 5
+	defaultDeliveryWorkers  = 16
+	defaultDeliveryTimeout  = 10 * time.Second
 )
 
 type Config struct {
@@ -15,6 +25,11 @@ type Config struct {
 	webhooksAddress   string
 	webhookUrl        string
 	tokenKey          string
+	configCacheSize   int
+	placementTimeout  time.Duration
+	deliveryAttempts  int
+	deliveryWorkers   int
+	deliveryTimeout   time.Duration
 }
 
 func (c *Config) NatsAddress() string {
@@ -57,7 +72,60 @@ func (c *Config) TokenKey() string {
 	return c.tokenKey
 }
 
+func (c *Config) ConfigCacheSize() int {
+	return c.configCacheSize
+}
+
+// PlacementTimeout is how long agents have to report the outcome of a
+// placement task unless the placement sets its own timeout.
+func (c *Config) PlacementTimeout() time.Duration {
+	return c.placementTimeout
+}
+
+// DeliveryAttempts is how many times a config is handed to the agent queue
+// before its placement task fails.
+func (c *Config) DeliveryAttempts() int {
+	return c.deliveryAttempts
+}
+
+// DeliveryWorkers is how many configs a placement hands to the agent queue
+// at once.
+func (c *Config) DeliveryWorkers() int {
+	return c.deliveryWorkers
+}
+
+// DeliveryTimeout bounds a single call to the agent queue.
+func (c *Config) DeliveryTimeout() time.Duration {
+	return c.deliveryTimeout
+}
+
 func NewFromEnv() (*Config, error) {
+	configCacheSize, err := intFromEnv("CONFIG_CACHE_SIZE", 0)
+	if err != nil {
+		return nil, err
+	}
+	placementTimeout, err := durationFromEnv("PLACEMENT_TIMEOUT", defaultPlacementTimeout)
+	if err != nil {
+		return nil, err
+	}
+	deliveryAttempts, err := intFromEnv("DELIVERY_ATTEMPTS", defaultDeliveryAttempts)
+	if err != nil {
+		return nil, err
+	}
+	deliveryWorkers, err := intFromEnv("DELIVERY_WORKERS", defaultDeliveryWorkers)
+	if err != nil {
+		return nil, err
+	}
+	if deliveryWorkers <= 0 {
+		return nil, fmt.Errorf("DELIVERY_WORKERS must be positive, got %d", deliveryWorkers)
+	}
+	deliveryTimeout, err := durationFromEnv("DELIVERY_TIMEOUT", defaultDeliveryTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if deliveryTimeout <= 0 {
+		return nil, fmt.Errorf("DELIVERY_TIMEOUT must be positive, got %s", deliveryTimeout)
+	}
 	return &Config{
 		natsAddress:       os.Getenv("NATS_ADDRESS"),
 		magnetarAddress:   os.Getenv("MAGNETAR_ADDRESS"),
@@ -69,5 +137,26 @@ func NewFromEnv() (*Config, error) {
 		webhooksAddress:   os.Getenv("WEBHOOK_ADDRESS"),
 		webhookUrl:        os.Getenv("WEBHOOK_URL"),
 		tokenKey:          os.Getenv("SECRET_KEY"),
+		configCacheSize:   configCacheSize,
+		placementTimeout:  placementTimeout,
+		deliveryAttempts:  deliveryAttempts,
+		deliveryWorkers:   deliveryWorkers,
+		deliveryTimeout:   deliveryTimeout,
 	}, nil
 }
+
+func intFromEnv(name string, defaultValue int) (int, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	return strconv.Atoi(value)
+}
+
+func durationFromEnv(name string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	return time.ParseDuration(value)
+}
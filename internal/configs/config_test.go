@@ -0,0 +1,45 @@
+package configs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewFromEnvDelivery(t *testing.T) {
+	tests := []struct {
+		name        string
+		workers     string
+		timeout     string
+		fails       bool
+		wantWorkers int
+		wantTimeout time.Duration
+	}{
+		{name: "defaults", wantWorkers: defaultDeliveryWorkers, wantTimeout: defaultDeliveryTimeout},
+		{name: "set", workers: "4", timeout: "3s", wantWorkers: 4, wantTimeout: 3 * time.Second},
+		{name: "no workers", workers: "0", fails: true},
+		{name: "negative workers", workers: "-2", fails: true},
+		{name: "workers not a number", workers: "many", fails: true},
+		{name: "no timeout", timeout: "0s", fails: true},
+		{name: "negative timeout", timeout: "-1s", fails: true},
+		{name: "timeout not a duration", timeout: "10", fails: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("DELIVERY_WORKERS", tt.workers)
+			t.Setenv("DELIVERY_TIMEOUT", tt.timeout)
+			config, err := NewFromEnv()
+			if tt.fails {
+				if err == nil {
+					t.Fatalf("NewFromEnv() = %+v, want error", config)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if config.DeliveryWorkers() != tt.wantWorkers || config.DeliveryTimeout() != tt.wantTimeout {
+				t.Errorf("delivery workers = %d, timeout = %s, want %d and %s", config.DeliveryWorkers(), config.DeliveryTimeout(), tt.wantWorkers, tt.wantTimeout)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ type Config interface {
 	Version() string
 	CreatedAtUnixSec() int64
 	CreatedAtUTC() time.Time
+	Labels() map[string]string
 	Type() string
 }
 
@@ -32,6 +33,7 @@ type ConfigBase struct {
 	namespace string
 	version   string
 	createdAt int64
+	labels    map[string]string
 }
 
 func (c *ConfigBase) Org() Org {
@@ -58,6 +60,15 @@ func (c *ConfigBase) CreatedAtUTC() time.Time {
 	return time.Unix(c.createdAt, 0).UTC()
 }
 
+// Labels are used to find configs and aren't part of their content.
+func (c *ConfigBase) Labels() map[string]string {
+	return c.labels
+}
+
+func (c *ConfigBase) SetLabels(labels map[string]string) {
+	c.labels = labels
+}
+
 type NamedParamSet struct {
 	name   string
 	params map[string]string
@@ -118,6 +129,7 @@ func (ps NamedParamSet) Diff(cmp NamedParamSet) []Diff {
 		}
 	}
 
+	sortDiffs(diffs)
 	return diffs
 }
 
@@ -218,9 +230,18 @@ func (c *ConfigGroup) Diff(cmp *ConfigGroup) map[string][]Diff {
 
 	groupNew := c
 	groupLatest := cmp
+	renames := groupNew.Renames(groupLatest)
+	renamedFrom := make(map[string]bool, len(renames))
+	for _, from := range renames {
+		renamedFrom[from] = true
+	}
 	for _, newParamSet := range groupNew.paramSets {
 		latestParamSet, err := groupLatest.ParamSet(newParamSet.name)
-		if err != nil {
+		if from, renamed := renames[newParamSet.name]; err != nil && renamed {
+			//param set moved under a new name
+			latestParamSet, _ = groupLatest.ParamSet(from)
+			diffs[newParamSet.name] = append([]Diff{Rename{From: from, To: newParamSet.name}}, newParamSet.Diff(latestParamSet)...)
+		} else if err != nil {
 			//addition of config in group
 			for key, value := range newParamSet.params {
 				newDiff := Addition{
@@ -235,7 +256,7 @@ func (c *ConfigGroup) Diff(cmp *ConfigGroup) map[string][]Diff {
 	}
 	for _, latestParamSet := range groupLatest.paramSets {
 		_, err := groupNew.ParamSet(latestParamSet.name)
-		if err != nil {
+		if err != nil && !renamedFrom[latestParamSet.name] {
 			//deletion of config in group
 			for key, value := range latestParamSet.params {
 				newDiff := Deletion{
@@ -247,6 +268,9 @@ func (c *ConfigGroup) Diff(cmp *ConfigGroup) map[string][]Diff {
 		}
 	}
 
+	for _, paramSetDiffs := range diffs {
+		sortDiffs(paramSetDiffs)
+	}
 	return diffs
 }
 
@@ -258,6 +282,9 @@ type StandaloneConfigStore interface {
 	Put(ctx context.Context, config *StandaloneConfig) *Error
 	Get(ctx context.Context, org Org, namespace, name, version string) (*StandaloneConfig, *Error)
 	List(ctx context.Context, org Org, namespace string) ([]*StandaloneConfig, *Error)
+	// Search returns up to limit of the org's configs accepted by match,
+	// starting after pageToken, along with the token of the next page.
+	Search(ctx context.Context, org Org, pageToken string, limit int, match func(config *StandaloneConfig) bool) ([]*StandaloneConfig, string, *Error)
 	Delete(ctx context.Context, org Org, namespace, name, version string) (*StandaloneConfig, *Error)
 }
 
@@ -265,5 +292,8 @@ type ConfigGroupStore interface {
 	Put(ctx context.Context, config *ConfigGroup) *Error
 	Get(ctx context.Context, org Org, namespace, name, version string) (*ConfigGroup, *Error)
 	List(ctx context.Context, org Org, namespace string) ([]*ConfigGroup, *Error)
+	// Search returns up to limit of the org's configs accepted by match,
+	// starting after pageToken, along with the token of the next page.
+	Search(ctx context.Context, org Org, pageToken string, limit int, match func(config *ConfigGroup) bool) ([]*ConfigGroup, string, *Error)
 	Delete(ctx context.Context, org Org, namespace, name, version string) (*ConfigGroup, *Error)
 }
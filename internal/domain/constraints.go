@@ -0,0 +1,226 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+const (
+	ConstraintExclude      = "exclude"
+	ConstraintAntiAffinity = "antiAffinity"
+	ConstraintMaxPerLabel  = "maxPerLabel"
+	ConstraintSpread       = "spread"
+)
+
+// CandidateNode is a node selected by a placement strategy.
+type CandidateNode struct {
+	Node   Node
+	Labels map[string]string
+}
+
+type ConstraintViolation struct {
+	Constraint string
+	Message    string
+}
+
+type PlacementConstraints struct {
+	// maximum number of nodes sharing a value of the label
+	MaxPerLabel map[string]int
+	// label whose values the nodes are spread evenly across
+	SpreadBy     string
+	ExcludeNodes []Node
+	// configs, regardless of version, whose nodes aren't selected
+	AntiAffinity []ConfigRef
+}
+
+func (c PlacementConstraints) Empty() bool {
+	return len(c.MaxPerLabel) == 0 && c.SpreadBy == "" && len(c.ExcludeNodes) == 0 && len(c.AntiAffinity) == 0
+}
+
+// Apply narrows the nodes down to the ones satisfying the constraints,
+// keeping their order, except that spreading interleaves the label values.
+// placed holds the configs placed on each node and is only needed for
+// anti-affinity. Constraints that left out some or all of the nodes are
+// reported as violations.
+func (c PlacementConstraints) Apply(nodes []CandidateNode, placed map[Node][]ConfigRef) ([]CandidateNode, []ConstraintViolation) {
+	violations := make([]ConstraintViolation, 0)
+	filter := func(constraint string, keep func(node CandidateNode) bool) {
+		before := len(nodes)
+		nodes = slices.DeleteFunc(nodes, func(node CandidateNode) bool {
+			return !keep(node)
+		})
+		if violation := filterViolation(constraint, before, len(nodes)); violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+
+	if len(c.ExcludeNodes) > 0 {
+		// excluding nodes is what was asked for, so it's only a violation
+		// if no node is left
+		before := len(nodes)
+		nodes = slices.DeleteFunc(nodes, func(node CandidateNode) bool {
+			return slices.Contains(c.ExcludeNodes, node.Node)
+		})
+		if before > 0 && len(nodes) == 0 {
+			violations = append(violations, *filterViolation(ConstraintExclude, before, 0))
+		}
+	}
+	if len(c.AntiAffinity) > 0 {
+		filter(ConstraintAntiAffinity, func(node CandidateNode) bool {
+			return !slices.ContainsFunc(placed[node.Node], func(ref ConfigRef) bool {
+				return slices.ContainsFunc(c.AntiAffinity, ref.SameConfig)
+			})
+		})
+	}
+	for _, label := range c.limitedLabels() {
+		counts := make(map[string]int)
+		filter(ConstraintMaxPerLabel, func(node CandidateNode) bool {
+			value, ok := node.Labels[label]
+			if !ok {
+				return true
+			}
+			counts[value]++
+			return counts[value] <= c.MaxPerLabel[label]
+		})
+	}
+	if c.SpreadBy != "" {
+		var violation *ConstraintViolation
+		nodes, violation = spread(nodes, c.SpreadBy)
+		if violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+	return nodes, violations
+}
+
+func filterViolation(constraint string, before, after int) *ConstraintViolation {
+	switch {
+	case after == before:
+		return nil
+	case after == 0:
+		return &ConstraintViolation{
+			Constraint: constraint,
+			Message:    "none of the selected nodes satisfy it",
+		}
+	default:
+		return &ConstraintViolation{
+			Constraint: constraint,
+			Message:    fmt.Sprintf("%d of the %d selected nodes don't satisfy it and were left out", before-after, before),
+		}
+	}
+}
+
+func (c PlacementConstraints) limitedLabels() []string {
+	labels := make([]string, 0, len(c.MaxPerLabel))
+	for label := range c.MaxPerLabel {
+		labels = append(labels, label)
+	}
+	slices.Sort(labels)
+	return labels
+}
+
+// WithoutMaxPerLabel returns the constraints without the per-label limits,
+// for staged rollouts that apply them to every stage with Stage instead.
+func (c PlacementConstraints) WithoutMaxPerLabel() PlacementConstraints {
+	c.MaxPerLabel = nil
+	return c
+}
+
+// Stage orders the nodes into stages of the given cumulative sizes so that no
+// stage has more than MaxPerLabel nodes sharing a value of a label. Nodes that
+// don't fit in a stage are moved to a later one, adding stages the size of
+// the last one if needed, and the cumulative sizes of the stages are returned
+// along with the nodes.
+func (c PlacementConstraints) Stage(nodes []CandidateNode, stages []int) ([]CandidateNode, []int, *ConstraintViolation) {
+	if len(c.MaxPerLabel) == 0 || len(nodes) == 0 {
+		return nodes, stages, nil
+	}
+	labels := c.limitedLabels()
+	remaining := slices.Clone(nodes)
+	ordered := make([]CandidateNode, 0, len(nodes))
+	cumulative := make([]int, 0, len(stages))
+	planned, size := 0, 1
+	for i := 0; len(remaining) > 0; i++ {
+		if i < len(stages) {
+			size = max(stages[i]-planned, 1)
+			planned = stages[i]
+		}
+		counts := make(map[string]map[string]int, len(labels))
+		for _, label := range labels {
+			counts[label] = make(map[string]int)
+		}
+		taken := 0
+		remaining = slices.DeleteFunc(remaining, func(node CandidateNode) bool {
+			if taken == size {
+				return false
+			}
+			for _, label := range labels {
+				if value, ok := node.Labels[label]; ok && counts[label][value] >= c.MaxPerLabel[label] {
+					return false
+				}
+			}
+			for _, label := range labels {
+				if value, ok := node.Labels[label]; ok {
+					counts[label][value]++
+				}
+			}
+			ordered = append(ordered, node)
+			taken++
+			return true
+		})
+		if taken > 0 {
+			cumulative = append(cumulative, len(ordered))
+		}
+	}
+	if slices.Equal(cumulative, stages) {
+		return ordered, cumulative, nil
+	}
+	return ordered, cumulative, &ConstraintViolation{
+		Constraint: ConstraintMaxPerLabel,
+		Message:    fmt.Sprintf("the nodes were rearranged into %d stages instead of %d to keep the limit in every stage", len(cumulative), len(stages)),
+	}
+}
+
+// spread caps every value of the label at an even share of the nodes and
+// orders the nodes round-robin across the values, so that every prefix of
+// them, such as a rollout stage, is spread as well. Nodes without the label
+// count as a value of their own.
+func spread(nodes []CandidateNode, label string) ([]CandidateNode, *ConstraintViolation) {
+	values := make([]string, 0)
+	byValue := make(map[string][]CandidateNode)
+	for _, node := range nodes {
+		value := node.Labels[label]
+		if _, ok := byValue[value]; !ok {
+			values = append(values, value)
+		}
+		byValue[value] = append(byValue[value], node)
+	}
+	if len(values) == 0 {
+		return nodes, nil
+	}
+	share := int(math.Ceil(float64(len(nodes)) / float64(len(values))))
+	short := make([]string, 0)
+	for _, value := range values {
+		if count := len(byValue[value]); count < share {
+			short = append(short, fmt.Sprintf("%s=%q has %d", label, value, count))
+		}
+	}
+	spread := make([]CandidateNode, 0, len(nodes))
+	for round := 0; round < share; round++ {
+		for _, value := range values {
+			if round < len(byValue[value]) {
+				spread = append(spread, byValue[value][round])
+			}
+		}
+	}
+	if len(short) == 0 {
+		return spread, nil
+	}
+	slices.Sort(short)
+	return spread, &ConstraintViolation{
+		Constraint: ConstraintSpread,
+		Message:    fmt.Sprintf("uneven, every value needs %d nodes but %s", share, strings.Join(short, ", ")),
+	}
+}
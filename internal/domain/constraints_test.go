@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+// candidate returns a node in the zone, or without the zone label if zone is empty.
+func candidate(id, zone string) CandidateNode {
+	labels := map[string]string{}
+	if zone != "" {
+		labels["zone"] = zone
+	}
+	return CandidateNode{Node: Node(id), Labels: labels}
+}
+
+func candidateIds(nodes []CandidateNode) []Node {
+	ids := make([]Node, 0, len(nodes))
+	for _, node := range nodes {
+		ids = append(ids, node.Node)
+	}
+	return ids
+}
+
+func TestPlacementConstraintsApply(t *testing.T) {
+	db := func(version string) ConfigRef {
+		return ConfigRef{Org: "org", Namespace: "ns", Name: "db", Version: version, Type: ConfTypeStandalone}
+	}
+	tests := []struct {
+		name        string
+		constraints PlacementConstraints
+		nodes       []CandidateNode
+		placed      map[Node][]ConfigRef
+		want        []Node
+		violations  []string
+	}{
+		{
+			name:  "no constraints",
+			nodes: []CandidateNode{candidate("a", "z1"), candidate("b", "z1")},
+			want:  []Node{"a", "b"},
+		},
+		{
+			name:        "excluded nodes aren't a violation",
+			constraints: PlacementConstraints{ExcludeNodes: []Node{"b"}},
+			nodes:       []CandidateNode{candidate("a", "z1"), candidate("b", "z1"), candidate("c", "z2")},
+			want:        []Node{"a", "c"},
+		},
+		{
+			name:        "excluding every node",
+			constraints: PlacementConstraints{ExcludeNodes: []Node{"a"}},
+			nodes:       []CandidateNode{candidate("a", "z1")},
+			want:        []Node{},
+			violations:  []string{ConstraintExclude},
+		},
+		{
+			name:        "anti-affinity ignores versions",
+			constraints: PlacementConstraints{AntiAffinity: []ConfigRef{db("v2")}},
+			nodes:       []CandidateNode{candidate("a", "z1"), candidate("b", "z1"), candidate("c", "z2")},
+			placed:      map[Node][]ConfigRef{"b": {db("v1")}, "c": {{Org: "org", Namespace: "other", Name: "db", Type: ConfTypeStandalone}}},
+			want:        []Node{"a", "c"},
+			violations:  []string{ConstraintAntiAffinity},
+		},
+		{
+			name:        "max per label keeps the first nodes and unlabelled ones",
+			constraints: PlacementConstraints{MaxPerLabel: map[string]int{"zone": 1}},
+			nodes:       []CandidateNode{candidate("a", "z1"), candidate("b", "z1"), candidate("c", "z2"), candidate("d", "")},
+			want:        []Node{"a", "c", "d"},
+			violations:  []string{ConstraintMaxPerLabel},
+		},
+		{
+			name:        "even spread interleaves the values",
+			constraints: PlacementConstraints{SpreadBy: "zone"},
+			nodes:       []CandidateNode{candidate("a", "z1"), candidate("b", "z1"), candidate("c", "z2"), candidate("d", "z2")},
+			want:        []Node{"a", "c", "b", "d"},
+		},
+		{
+			name:        "uneven spread caps the larger values",
+			constraints: PlacementConstraints{SpreadBy: "zone"},
+			nodes:       []CandidateNode{candidate("a", "z1"), candidate("b", "z1"), candidate("c", "z1"), candidate("d", "z2")},
+			want:        []Node{"a", "d", "b"},
+			violations:  []string{ConstraintSpread},
+		},
+		{
+			name:        "nodes without the label are spread as a value of their own",
+			constraints: PlacementConstraints{SpreadBy: "zone"},
+			nodes:       []CandidateNode{candidate("a", "z1"), candidate("b", ""), candidate("c", "z1"), candidate("d", "")},
+			want:        []Node{"a", "b", "c", "d"},
+		},
+		{
+			name:        "constraints are applied in turn",
+			constraints: PlacementConstraints{ExcludeNodes: []Node{"a"}, MaxPerLabel: map[string]int{"zone": 1}, SpreadBy: "zone"},
+			nodes:       []CandidateNode{candidate("a", "z1"), candidate("b", "z1"), candidate("c", "z1"), candidate("d", "z2")},
+			want:        []Node{"b", "d"},
+			violations:  []string{ConstraintMaxPerLabel},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes, violations := tt.constraints.Apply(tt.nodes, tt.placed)
+			if ids := candidateIds(nodes); !slices.Equal(ids, tt.want) {
+				t.Errorf("nodes = %v, want %v", ids, tt.want)
+			}
+			constraints := make([]string, 0)
+			for _, violation := range violations {
+				constraints = append(constraints, violation.Constraint)
+			}
+			if !slices.Equal(constraints, tt.violations) {
+				t.Errorf("violations = %v, want %v", violations, tt.violations)
+			}
+		})
+	}
+}
+
+func TestPlacementConstraintsStage(t *testing.T) {
+	limited := PlacementConstraints{MaxPerLabel: map[string]int{"zone": 1}}
+	tests := []struct {
+		name        string
+		constraints PlacementConstraints
+		nodes       []CandidateNode
+		stages      []int
+		want        []Node
+		wantStages  []int
+		violation   bool
+	}{
+		{
+			name:       "no limits",
+			nodes:      []CandidateNode{candidate("a", "z1"), candidate("b", "z1")},
+			stages:     []int{1, 2},
+			want:       []Node{"a", "b"},
+			wantStages: []int{1, 2},
+		},
+		{
+			name:        "nodes reordered within the stages",
+			constraints: limited,
+			nodes:       []CandidateNode{candidate("a", "z1"), candidate("b", "z1"), candidate("c", "z2"), candidate("d", "z2")},
+			stages:      []int{2, 4},
+			want:        []Node{"a", "c", "b", "d"},
+			wantStages:  []int{2, 4},
+		},
+		{
+			name:        "stages added for nodes that don't fit",
+			constraints: limited,
+			nodes:       []CandidateNode{candidate("a", "z1"), candidate("b", "z1"), candidate("c", "z2"), candidate("d", "z2")},
+			stages:      []int{1, 4},
+			want:        []Node{"a", "b", "c", "d"},
+			wantStages:  []int{1, 3, 4},
+			violation:   true,
+		},
+		{
+			name:        "unlabelled nodes aren't limited",
+			constraints: limited,
+			nodes:       []CandidateNode{candidate("a", ""), candidate("b", ""), candidate("c", "")},
+			stages:      []int{3},
+			want:        []Node{"a", "b", "c"},
+			wantStages:  []int{3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes, stages, violation := tt.constraints.Stage(tt.nodes, tt.stages)
+			if ids := candidateIds(nodes); !slices.Equal(ids, tt.want) {
+				t.Errorf("nodes = %v, want %v", ids, tt.want)
+			}
+			if !slices.Equal(stages, tt.wantStages) {
+				t.Errorf("stages = %v, want %v", stages, tt.wantStages)
+			}
+			if (violation != nil) != tt.violation {
+				t.Errorf("violation = %v, want %t", violation, tt.violation)
+			}
+		})
+	}
+}
+
+func TestFilterViolation(t *testing.T) {
+	tests := []struct {
+		name    string
+		before  int
+		after   int
+		message string
+	}{
+		{name: "nothing left out", before: 3, after: 3},
+		{name: "some left out", before: 3, after: 1, message: "2 of the 3 selected nodes don't satisfy it and were left out"},
+		{name: "all left out", before: 3, after: 0, message: "none of the selected nodes satisfy it"},
+		{name: "no nodes to begin with", before: 0, after: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violation := filterViolation(ConstraintSpread, tt.before, tt.after)
+			message := ""
+			if violation != nil {
+				message = violation.Message
+			}
+			if message != tt.message {
+				t.Errorf("message = %q, want %q", message, tt.message)
+			}
+		})
+	}
+}
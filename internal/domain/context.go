@@ -0,0 +1,14 @@
+package domain
+
+import "context"
+
+type linearizableReadKey struct{}
+
+func WithLinearizableRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, linearizableReadKey{}, true)
+}
+
+func IsLinearizableRead(ctx context.Context) bool {
+	linearizable, _ := ctx.Value(linearizableReadKey{}).(bool)
+	return linearizable
+}
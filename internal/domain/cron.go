@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule is a parsed standard five field cron expression
+// (minute, hour, day of month, month, day of week) evaluated in UTC.
+type CronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// day of month and day of week match on either if both are restricted
+	domStar, dowStar bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+func ParseCronSchedule(expr string) (CronSchedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFields) {
+		return CronSchedule{}, fmt.Errorf("cron expression must have %d fields, got %d", len(cronFields), len(parts))
+	}
+	bits := make([]uint64, len(parts))
+	for i, part := range parts {
+		b, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return CronSchedule{}, err
+		}
+		bits[i] = b
+	}
+	// sunday is both 0 and 7
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+	return CronSchedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: parts[2] == "*",
+		dowStar: parts[4] == "*",
+	}, nil
+}
+
+// parseCronField supports *, single values, ranges (a-b), steps (*/n, a-b/n)
+// and comma separated lists of those.
+func parseCronField(field string, def cronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepPart, def.name)
+			}
+		}
+		from, to := def.min, def.max
+		if rangePart != "*" {
+			lo, hi, isRange := strings.Cut(rangePart, "-")
+			var err error
+			from, err = strconv.Atoi(lo)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q in %s field", lo, def.name)
+			}
+			to = from
+			if isRange {
+				to, err = strconv.Atoi(hi)
+				if err != nil {
+					return 0, fmt.Errorf("invalid value %q in %s field", hi, def.name)
+				}
+			} else if hasStep {
+				to = def.max
+			}
+		}
+		if from < def.min || to > def.max || from > to {
+			return 0, fmt.Errorf("%s field must be between %d and %d", def.name, def.min, def.max)
+		}
+		for v := from; v <= to; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+// Matches is true if the schedule fires at the minute t falls in.
+func (c CronSchedule) Matches(t time.Time) bool {
+	t = t.UTC()
+	if c.minute&(1<<t.Minute()) == 0 || c.hour&(1<<t.Hour()) == 0 || c.month&(1<<int(t.Month())) == 0 {
+		return false
+	}
+	domMatch := c.dom&(1<<t.Day()) != 0
+	dowMatch := c.dow&(1<<int(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
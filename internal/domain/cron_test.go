@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	tests := []struct {
+		expr  string
+		valid bool
+	}{
+		{expr: "* * * * *", valid: true},
+		{expr: "*/15 9-17 1,15 1-12/3 1-5", valid: true},
+		{expr: "0 0 * * 7", valid: true},
+		{expr: "  0   0 * *  0 ", valid: true},
+		{expr: "* * * *"},
+		{expr: "* * * * * *"},
+		{expr: "60 * * * *"},
+		{expr: "* 24 * * *"},
+		{expr: "* * 0 * *"},
+		{expr: "* * * 13 *"},
+		{expr: "* * * * 8"},
+		{expr: "*/0 * * * *"},
+		{expr: "*/-1 * * * *"},
+		{expr: "5-1 * * * *"},
+		{expr: "a * * * *"},
+		{expr: "1-b * * * *"},
+		{expr: "1,,2 * * * *"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseCronSchedule(tt.expr)
+			if (err == nil) != tt.valid {
+				t.Errorf("err = %v, want valid = %t", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestCronScheduleMatches(t *testing.T) {
+	// 2024-01-01 is a monday
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name    string
+		expr    string
+		at      time.Time
+		matches bool
+	}{
+		{name: "every minute", expr: "* * * * *", at: at(1, 1, 0, 0), matches: true},
+		{name: "step", expr: "*/15 * * * *", at: at(1, 1, 10, 30), matches: true},
+		{name: "off step", expr: "*/15 * * * *", at: at(1, 1, 10, 31)},
+		{name: "step from a value", expr: "10/20 * * * *", at: at(1, 1, 10, 50), matches: true},
+		{name: "off step from a value", expr: "10/20 * * * *", at: at(1, 1, 10, 20)},
+		{name: "list with a stepped range", expr: "5,10-14/2 * * * *", at: at(1, 1, 0, 12), matches: true},
+		{name: "skipped by the stepped range", expr: "5,10-14/2 * * * *", at: at(1, 1, 0, 11)},
+		{name: "working hours", expr: "0 9-17 * * 1-5", at: at(1, 1, 9, 0), matches: true},
+		{name: "after working hours", expr: "0 9-17 * * 1-5", at: at(1, 1, 18, 0)},
+		{name: "weekend", expr: "0 9-17 * * 1-5", at: at(1, 6, 9, 0)},
+		{name: "sunday as 7", expr: "0 0 * * 7", at: at(1, 7, 0, 0), matches: true},
+		{name: "sunday as 0", expr: "0 0 * * 0", at: at(1, 7, 0, 0), matches: true},
+		{name: "month", expr: "30 2 * 2 *", at: at(2, 10, 2, 30), matches: true},
+		{name: "other month", expr: "30 2 * 2 *", at: at(1, 10, 2, 30)},
+		{name: "day of month only", expr: "0 0 1 * *", at: at(1, 7, 0, 0)},
+		{name: "either day matches by day of month", expr: "0 0 1 * 0", at: at(1, 1, 0, 0), matches: true},
+		{name: "either day matches by day of week", expr: "0 0 1 * 0", at: at(1, 7, 0, 0), matches: true},
+		{name: "neither day", expr: "0 0 1 * 0", at: at(1, 2, 0, 0)},
+		{name: "evaluated in UTC", expr: "0 9 * * *", at: time.Date(2024, 1, 1, 11, 0, 0, 0, time.FixedZone("CEST", 2*60*60)), matches: true},
+		{name: "seconds are ignored", expr: "0 9 * * *", at: at(1, 1, 9, 0).Add(59 * time.Second), matches: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCronSchedule(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := schedule.Matches(tt.at); got != tt.matches {
+				t.Errorf("Matches(%s) = %t, want %t", tt.at, got, tt.matches)
+			}
+		})
+	}
+}
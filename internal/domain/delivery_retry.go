@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DeliveryRetry is a placement task whose config couldn't be handed to the
+// agent queue and has to be sent again.
+type DeliveryRetry struct {
+	Config   ConfigRef
+	TaskId   string
+	Node     Node
+	Strategy string
+	// number of delivery attempts made so far
+	Attempts      int
+	NextAttemptAt int64
+	LastError     string
+}
+
+func (r DeliveryRetry) Due(now time.Time) bool {
+	return now.Unix() >= r.NextAttemptAt
+}
+
+type DeliveryRetryStore interface {
+	Put(ctx context.Context, retry DeliveryRetry) *Error
+	ListDue(ctx context.Context, now time.Time) ([]DeliveryRetry, *Error)
+	Delete(ctx context.Context, org Org, taskId string) *Error
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"log"
 	"slices"
+	"strings"
 )
 
 type Diff interface {
@@ -18,6 +19,7 @@ const (
 	DiffTypeAddition DiffType = "addition"
 	DiffTypeReplace  DiffType = "replacement"
 	DiffTypeDeletion DiffType = "deletion"
+	DiffTypeRename   DiffType = "rename"
 )
 
 func GetDiffTypeValues() []DiffType {
@@ -25,6 +27,7 @@ func GetDiffTypeValues() []DiffType {
 		DiffTypeAddition,
 		DiffTypeReplace,
 		DiffTypeDeletion,
+		DiffTypeRename,
 	}
 }
 
@@ -36,6 +39,12 @@ func (dt *DiffType) IsValid() bool {
 	return false
 }
 
+func sortDiffs(diffs []Diff) {
+	slices.SortFunc(diffs, func(a, b Diff) int {
+		return strings.Compare(a.Diff()["key"], b.Diff()["key"])
+	})
+}
+
 type Addition struct {
 	Key   string
 	Value string
@@ -141,3 +150,73 @@ func (d Deletion) Diff() map[string]string {
 		"value": d.Value,
 	}
 }
+
+// Rename is reported for a param set of a group that was moved under a new
+// name, followed by the differences in its params, if any.
+type Rename struct {
+	From string
+	To   string
+}
+
+func (Rename) Type() DiffType {
+	return DiffTypeRename
+}
+
+func (r Rename) String() string {
+	str := struct {
+		Type string `json:"type"`
+		From string `json:"from"`
+		To   string `json:"to"`
+	}{
+		Type: string(r.Type()),
+		From: r.From,
+		To:   r.To,
+	}
+	jsonBytes, err := json.Marshal(str)
+	if err != nil {
+		log.Println(err)
+		return ""
+	}
+	return string(jsonBytes)
+}
+
+func (r Rename) Diff() map[string]string {
+	return map[string]string{
+		"key":  "",
+		"from": r.From,
+		"to":   r.To,
+	}
+}
+
+type DiffStats struct {
+	Additions    int
+	Replacements int
+	Deletions    int
+	Renames      int
+}
+
+func CountDiffs(diffs []Diff) DiffStats {
+	stats := DiffStats{}
+	for _, diff := range diffs {
+		switch diff.Type() {
+		case DiffTypeAddition:
+			stats.Additions++
+		case DiffTypeReplace:
+			stats.Replacements++
+		case DiffTypeDeletion:
+			stats.Deletions++
+		case DiffTypeRename:
+			stats.Renames++
+		}
+	}
+	return stats
+}
+
+func (s DiffStats) Add(other DiffStats) DiffStats {
+	return DiffStats{
+		Additions:    s.Additions + other.Additions,
+		Replacements: s.Replacements + other.Replacements,
+		Deletions:    s.Deletions + other.Deletions,
+		Renames:      s.Renames + other.Renames,
+	}
+}
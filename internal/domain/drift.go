@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type AppliedConfig struct {
+	Node        Node
+	Config      ConfigRef
+	ContentHash string
+	TaskId      string
+	ReportedAt  int64
+}
+
+func (a AppliedConfig) ReportedAtUTC() time.Time {
+	return time.Unix(a.ReportedAt, 0).UTC()
+}
+
+type ConfigDrift struct {
+	Node         Node
+	Expected     ConfigRef
+	ExpectedHash string
+	// nil if the node didn't report the config at all
+	Applied *AppliedConfig
+	// param-level differences between the expected and the applied version,
+	// keyed by param set name; empty when the applied content is unknown
+	Diffs map[string][]Diff
+}
+
+type AppliedConfigStore interface {
+	Put(ctx context.Context, applied AppliedConfig) *Error
+	ReplaceForNode(ctx context.Context, org Org, node Node, applied []AppliedConfig) *Error
+	ListByOrg(ctx context.Context, org Org) ([]AppliedConfig, *Error)
+}
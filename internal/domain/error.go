@@ -10,6 +10,8 @@ const (
 	ErrTypeUnauthorized
 	ErrTypeInternal
 	ErrTypeSchemaInvalid
+	ErrTypeConflict
+	ErrTypeFailedPrecondition
 )
 
 type Error struct {
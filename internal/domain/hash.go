@@ -0,0 +1,20 @@
+package domain
+
+import "github.com/c12s/kuiper/pkg/confighash"
+
+// Hash returns a digest of the params that is independent of map ordering.
+func (ps NamedParamSet) Hash() string {
+	return confighash.Params(ps.params)
+}
+
+func (c *StandaloneConfig) ContentHash() string {
+	return c.paramSet.Hash()
+}
+
+func (c *ConfigGroup) ContentHash() string {
+	paramSets := make(map[string]map[string]string, len(c.paramSets))
+	for _, ps := range c.paramSets {
+		paramSets[ps.name] = ps.params
+	}
+	return confighash.ParamSets(paramSets)
+}
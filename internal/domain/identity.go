@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// ServiceIdentity is who background work runs as: the permissions on an org of
+// the caller who requested the work, captured when it was requested.
+type ServiceIdentity struct {
+	Org         Org
+	Subject     string
+	Permissions []string
+	// unix time the caller's token expired at, the work can't run as them
+	// afterwards. Zero if the token didn't expire.
+	ExpiresAt int64
+}
+
+func (i ServiceIdentity) Expired(now time.Time) bool {
+	return i.ExpiresAt > 0 && now.Unix() >= i.ExpiresAt
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const MaxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+// MaintenanceWindow is a recurring period during which configs may be placed
+// in a namespace, or in the whole org if Namespace is empty. It opens every
+// time Schedule fires and stays open for Duration.
+type MaintenanceWindow struct {
+	Org       Org
+	Namespace string
+	Name      string
+	Schedule  string
+	Duration  time.Duration
+	CreatedAt int64
+}
+
+// Open is true if at falls within an occurrence of the window.
+func (w MaintenanceWindow) Open(at time.Time) bool {
+	schedule, err := ParseCronSchedule(w.Schedule)
+	if err != nil {
+		return false
+	}
+	at = at.UTC()
+	since := at.Add(-w.Duration)
+	for start := at.Truncate(time.Minute); start.After(since); start = start.Add(-time.Minute) {
+		if schedule.Matches(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// Applies is true if the window restricts placements in the namespace.
+func (w MaintenanceWindow) Applies(namespace string) bool {
+	return w.Namespace == "" || w.Namespace == namespace
+}
+
+// PlacementAllowed is true if no window applies to the namespace or one of
+// those that do is open at the given time.
+func PlacementAllowed(windows []MaintenanceWindow, namespace string, at time.Time) bool {
+	restricted := false
+	for _, window := range windows {
+		if !window.Applies(namespace) {
+			continue
+		}
+		if window.Open(at) {
+			return true
+		}
+		restricted = true
+	}
+	return !restricted
+}
+
+type MaintenanceWindowStore interface {
+	Put(ctx context.Context, window MaintenanceWindow) *Error
+	Delete(ctx context.Context, org Org, namespace, name string) (*MaintenanceWindow, *Error)
+	ListByOrg(ctx context.Context, org Org) ([]MaintenanceWindow, *Error)
+}
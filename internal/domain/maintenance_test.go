@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMaintenanceWindowOpen(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+	nightly := MaintenanceWindow{Schedule: "0 2 * * *", Duration: 2 * time.Hour}
+	tests := []struct {
+		name   string
+		window MaintenanceWindow
+		at     time.Time
+		open   bool
+	}{
+		{name: "before", window: nightly, at: at(1, 1, 59)},
+		{name: "opening", window: nightly, at: at(1, 2, 0), open: true},
+		{name: "last minute", window: nightly, at: at(1, 3, 59).Add(59 * time.Second), open: true},
+		{name: "closing", window: nightly, at: at(1, 4, 0)},
+		{
+			name:   "over midnight",
+			window: MaintenanceWindow{Schedule: "0 23 * * *", Duration: 2 * time.Hour},
+			at:     at(2, 0, 30),
+			open:   true,
+		},
+		{
+			name:   "no duration",
+			window: MaintenanceWindow{Schedule: "* * * * *"},
+			at:     at(1, 2, 0),
+		},
+		{
+			name:   "invalid schedule",
+			window: MaintenanceWindow{Schedule: "* *", Duration: time.Hour},
+			at:     at(1, 2, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Open(tt.at); got != tt.open {
+				t.Errorf("Open(%s) = %t, want %t", tt.at, got, tt.open)
+			}
+		})
+	}
+}
+
+func TestPlacementAllowed(t *testing.T) {
+	at := time.Date(2024, 1, 1, 2, 30, 0, 0, time.UTC)
+	open := func(namespace string) MaintenanceWindow {
+		return MaintenanceWindow{Namespace: namespace, Schedule: "0 2 * * *", Duration: time.Hour}
+	}
+	closed := func(namespace string) MaintenanceWindow {
+		return MaintenanceWindow{Namespace: namespace, Schedule: "0 12 * * *", Duration: time.Hour}
+	}
+	tests := []struct {
+		name    string
+		windows []MaintenanceWindow
+		allowed bool
+	}{
+		{name: "no windows", allowed: true},
+		{name: "window of another namespace", windows: []MaintenanceWindow{closed("other")}, allowed: true},
+		{name: "closed namespace window", windows: []MaintenanceWindow{closed("ns")}},
+		{name: "closed org window", windows: []MaintenanceWindow{closed("")}},
+		{name: "open namespace window", windows: []MaintenanceWindow{closed(""), open("ns")}, allowed: true},
+		{name: "open org window", windows: []MaintenanceWindow{closed("ns"), open("")}, allowed: true},
+		{name: "open window of another namespace", windows: []MaintenanceWindow{closed("ns"), open("other")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PlacementAllowed(tt.windows, "ns", at); got != tt.allowed {
+				t.Errorf("PlacementAllowed() = %t, want %t", got, tt.allowed)
+			}
+		})
+	}
+}
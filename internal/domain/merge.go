@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+type MergeConflict struct {
+	ParamSet string
+	Key      string
+	Ours     Diff
+	Theirs   Diff
+}
+
+// Merge performs a three-way merge of ours and theirs using ps as their common
+// base. Keys changed differently on both sides are reported as conflicts and
+// keep their base value in the merged param set.
+func (ps NamedParamSet) Merge(ours, theirs NamedParamSet) (NamedParamSet, []MergeConflict) {
+	oursDiffs := diffsByKey(ours.Diff(ps))
+	theirsDiffs := diffsByKey(theirs.Diff(ps))
+
+	merged := make(map[string]string, len(ps.params))
+	for key, value := range ps.params {
+		merged[key] = value
+	}
+	conflicts := make([]MergeConflict, 0)
+	for key, oursDiff := range oursDiffs {
+		theirsDiff, changedByTheirs := theirsDiffs[key]
+		if changedByTheirs && !sameChange(oursDiff, theirsDiff) {
+			conflicts = append(conflicts, MergeConflict{
+				ParamSet: ours.name,
+				Key:      key,
+				Ours:     oursDiff,
+				Theirs:   theirsDiff,
+			})
+			continue
+		}
+		applyDiff(merged, key, oursDiff)
+	}
+	for key, theirsDiff := range theirsDiffs {
+		if _, changedByOurs := oursDiffs[key]; !changedByOurs {
+			applyDiff(merged, key, theirsDiff)
+		}
+	}
+	slices.SortFunc(conflicts, func(a, b MergeConflict) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return NamedParamSet{name: ours.name, params: merged}, conflicts
+}
+
+func (c *StandaloneConfig) Merge(ours, theirs *StandaloneConfig, version string) (*StandaloneConfig, []MergeConflict) {
+	paramSet, conflicts := c.paramSet.Merge(ours.paramSet, theirs.paramSet)
+	merged := NewStandaloneConfig(ours.org, ours.namespace, version, paramSet)
+	merged.SetLabels(ours.labels)
+	return merged, conflicts
+}
+
+// Merge merges every param set of ours and theirs against c. A param set
+// missing from a side is merged as an empty one, so deleting a param set on
+// one side conflicts with changes made to it on the other.
+func (c *ConfigGroup) Merge(ours, theirs *ConfigGroup, version string) (*ConfigGroup, []MergeConflict) {
+	names := make([]string, 0)
+	for _, group := range []*ConfigGroup{ours, theirs, c} {
+		for _, ps := range group.paramSets {
+			if !slices.Contains(names, ps.name) {
+				names = append(names, ps.name)
+			}
+		}
+	}
+
+	paramSets := make([]NamedParamSet, 0, len(names))
+	conflicts := make([]MergeConflict, 0)
+	for _, name := range names {
+		base, baseErr := c.ParamSet(name)
+		oursSet, oursErr := ours.ParamSet(name)
+		theirsSet, theirsErr := theirs.ParamSet(name)
+		inBase, inOurs, inTheirs := baseErr == nil, oursErr == nil, theirsErr == nil
+		oursSet.name = name
+
+		merged, setConflicts := base.Merge(oursSet, theirsSet)
+		conflicts = append(conflicts, setConflicts...)
+		keep := (inOurs && inTheirs) || (!inBase && (inOurs || inTheirs)) || len(merged.params) > 0 || len(setConflicts) > 0
+		if keep {
+			paramSets = append(paramSets, merged)
+		}
+	}
+	merged := NewConfigGroup(ours.org, ours.namespace, ours.name, version, paramSets)
+	merged.SetLabels(ours.labels)
+	return merged, conflicts
+}
+
+func diffsByKey(diffs []Diff) map[string]Diff {
+	byKey := make(map[string]Diff, len(diffs))
+	for _, diff := range diffs {
+		byKey[diff.Diff()["key"]] = diff
+	}
+	return byKey
+}
+
+func sameChange(a, b Diff) bool {
+	aValue, aExists := diffResult(a)
+	bValue, bExists := diffResult(b)
+	return aExists == bExists && aValue == bValue
+}
+
+func diffResult(diff Diff) (string, bool) {
+	switch d := diff.(type) {
+	case Addition:
+		return d.Value, true
+	case Replace:
+		return d.New, true
+	default:
+		return "", false
+	}
+}
+
+func applyDiff(params map[string]string, key string, diff Diff) {
+	if value, exists := diffResult(diff); exists {
+		params[key] = value
+	} else {
+		delete(params, key)
+	}
+}
@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestParamSetMerge(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      map[string]string
+		ours      map[string]string
+		theirs    map[string]string
+		merged    map[string]string
+		conflicts []string
+	}{
+		{
+			name:   "unchanged",
+			base:   map[string]string{"a": "1"},
+			ours:   map[string]string{"a": "1"},
+			theirs: map[string]string{"a": "1"},
+			merged: map[string]string{"a": "1"},
+		},
+		{
+			name:   "changes to different keys",
+			base:   map[string]string{"a": "1", "b": "1"},
+			ours:   map[string]string{"a": "2", "b": "1"},
+			theirs: map[string]string{"a": "1", "b": "2", "c": "3"},
+			merged: map[string]string{"a": "2", "b": "2", "c": "3"},
+		},
+		{
+			name:   "same change on both sides",
+			base:   map[string]string{"a": "1"},
+			ours:   map[string]string{"a": "2"},
+			theirs: map[string]string{"a": "2"},
+			merged: map[string]string{"a": "2"},
+		},
+		{
+			name:      "different changes keep the base value",
+			base:      map[string]string{"a": "1", "b": "1"},
+			ours:      map[string]string{"a": "2", "b": "1"},
+			theirs:    map[string]string{"a": "3", "b": "2"},
+			merged:    map[string]string{"a": "1", "b": "2"},
+			conflicts: []string{"a"},
+		},
+		{
+			name:   "deleted on one side",
+			base:   map[string]string{"a": "1", "b": "1"},
+			ours:   map[string]string{"b": "1"},
+			theirs: map[string]string{"a": "1", "b": "1"},
+			merged: map[string]string{"b": "1"},
+		},
+		{
+			name:   "deleted on both sides",
+			base:   map[string]string{"a": "1"},
+			ours:   map[string]string{},
+			theirs: map[string]string{},
+			merged: map[string]string{},
+		},
+		{
+			name:      "deleted on one side and changed on the other",
+			base:      map[string]string{"a": "1"},
+			ours:      map[string]string{},
+			theirs:    map[string]string{"a": "2"},
+			merged:    map[string]string{"a": "1"},
+			conflicts: []string{"a"},
+		},
+		{
+			name:      "added differently on both sides",
+			base:      map[string]string{},
+			ours:      map[string]string{"b": "1", "a": "1"},
+			theirs:    map[string]string{"b": "2", "a": "2"},
+			merged:    map[string]string{},
+			conflicts: []string{"a", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base := NewParamSet("ps", tt.base)
+			merged, conflicts := base.Merge(*NewParamSet("ps", tt.ours), *NewParamSet("ps", tt.theirs))
+			if !maps.Equal(merged.ParamSet(), tt.merged) {
+				t.Errorf("merged = %v, want %v", merged.ParamSet(), tt.merged)
+			}
+			keys := make([]string, 0, len(conflicts))
+			for _, conflict := range conflicts {
+				keys = append(keys, conflict.Key)
+			}
+			if !slices.Equal(keys, tt.conflicts) {
+				t.Errorf("conflicts = %v, want %v", keys, tt.conflicts)
+			}
+			if !maps.Equal(base.ParamSet(), tt.base) {
+				t.Errorf("base was modified to %v", base.ParamSet())
+			}
+		})
+	}
+}
+
+func TestConfigGroupMerge(t *testing.T) {
+	group := func(paramSets map[string]map[string]string) *ConfigGroup {
+		sets := make([]NamedParamSet, 0, len(paramSets))
+		for name, params := range paramSets {
+			sets = append(sets, *NewParamSet(name, params))
+		}
+		return NewConfigGroup("org", "ns", "group", "v1", sets)
+	}
+	tests := []struct {
+		name      string
+		base      map[string]map[string]string
+		ours      map[string]map[string]string
+		theirs    map[string]map[string]string
+		paramSets []string
+		conflicts int
+	}{
+		{
+			name:      "param set added on one side",
+			base:      map[string]map[string]string{"a": {"k": "1"}},
+			ours:      map[string]map[string]string{"a": {"k": "1"}, "b": {"k": "1"}},
+			theirs:    map[string]map[string]string{"a": {"k": "1"}},
+			paramSets: []string{"a", "b"},
+		},
+		{
+			name:      "param set deleted on one side",
+			base:      map[string]map[string]string{"a": {"k": "1"}, "b": {"k": "1"}},
+			ours:      map[string]map[string]string{"a": {"k": "1"}},
+			theirs:    map[string]map[string]string{"a": {"k": "1"}, "b": {"k": "1"}},
+			paramSets: []string{"a"},
+		},
+		{
+			name:      "param set deleted on one side and changed on the other",
+			base:      map[string]map[string]string{"a": {"k": "1"}, "b": {"k": "1"}},
+			ours:      map[string]map[string]string{"a": {"k": "1"}},
+			theirs:    map[string]map[string]string{"a": {"k": "1"}, "b": {"k": "2"}},
+			paramSets: []string{"a", "b"},
+			conflicts: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, conflicts := group(tt.base).Merge(group(tt.ours), group(tt.theirs), "v2")
+			names := make([]string, 0)
+			for _, ps := range merged.ParamSets() {
+				names = append(names, ps.Name())
+			}
+			slices.Sort(names)
+			if !slices.Equal(names, tt.paramSets) {
+				t.Errorf("param sets = %v, want %v", names, tt.paramSets)
+			}
+			if len(conflicts) != tt.conflicts {
+				t.Errorf("%d conflicts, want %d", len(conflicts), tt.conflicts)
+			}
+			if merged.Version() != "v2" {
+				t.Errorf("version = %s, want v2", merged.Version())
+			}
+		})
+	}
+}
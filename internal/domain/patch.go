@@ -0,0 +1,532 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type PatchFormat int8
+
+const (
+	PatchFormatNone PatchFormat = iota
+	PatchFormatJSONPatch
+	PatchFormatMergePatch
+	PatchFormatUnified
+)
+
+// JSONPatchOp is a single RFC 6902 operation.
+type JSONPatchOp struct {
+	Op    string `json:"op"`
+	From  string `json:"from,omitempty"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+	// whether the parsed operation had a value, even a null one
+	hasValue bool
+}
+
+// MarshalJSON sets value on the operations taking one even if it's empty,
+// e.g. "" or false, and leaves it out of the others.
+func (op JSONPatchOp) MarshalJSON() ([]byte, error) {
+	if op.takesValue() {
+		return json.Marshal(struct {
+			Op    string `json:"op"`
+			Path  string `json:"path"`
+			Value any    `json:"value"`
+		}{Op: op.Op, Path: op.Path, Value: op.Value})
+	}
+	return json.Marshal(struct {
+		Op   string `json:"op"`
+		From string `json:"from,omitempty"`
+		Path string `json:"path"`
+	}{Op: op.Op, From: op.From, Path: op.Path})
+}
+
+func (op *JSONPatchOp) UnmarshalJSON(data []byte) error {
+	var parsed struct {
+		Op    string          `json:"op"`
+		From  string          `json:"from"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return err
+	}
+	*op = JSONPatchOp{Op: parsed.Op, From: parsed.From, Path: parsed.Path, hasValue: parsed.Value != nil}
+	if op.hasValue {
+		return json.Unmarshal(parsed.Value, &op.Value)
+	}
+	return nil
+}
+
+func (op JSONPatchOp) takesValue() bool {
+	return op.Op == "add" || op.Op == "replace" || op.Op == "test"
+}
+
+// JSONPatch returns the RFC 6902 patch that applies diffs to a param set
+// rendered as a flat JSON object.
+func JSONPatch(diffs []Diff) ([]byte, error) {
+	return json.Marshal(jsonPatchOps("", diffs))
+}
+
+// MergePatch returns the RFC 7386 merge patch that applies diffs to a param
+// set rendered as a flat JSON object.
+func MergePatch(diffs []Diff) ([]byte, error) {
+	return json.Marshal(mergePatch(diffs))
+}
+
+// JSONPatch returns the RFC 6902 patch turning reference into c, with the
+// group rendered as an object of param set objects. Renamed param sets are
+// moved rather than removed and added again.
+func (c *ConfigGroup) JSONPatch(reference *ConfigGroup) ([]byte, error) {
+	renames := c.Renames(reference)
+	renamedFrom := make(map[string]bool, len(renames))
+	for _, from := range renames {
+		renamedFrom[from] = true
+	}
+	ops := make([]JSONPatchOp, 0)
+	for _, name := range paramSetNames(c, reference) {
+		newSet, newErr := c.ParamSet(name)
+		refSet, refErr := reference.ParamSet(name)
+		path := "/" + escapeJSONPointer(name)
+		from, renamed := renames[name]
+		switch {
+		case refErr != nil && renamed:
+			fromSet, _ := reference.ParamSet(from)
+			ops = append(ops, JSONPatchOp{Op: "move", From: "/" + escapeJSONPointer(from), Path: path})
+			ops = append(ops, jsonPatchOps(path, newSet.Diff(fromSet))...)
+		case refErr != nil:
+			ops = append(ops, JSONPatchOp{Op: "add", Path: path, Value: paramsObject(newSet)})
+		case newErr != nil && renamedFrom[name]:
+			continue
+		case newErr != nil:
+			ops = append(ops, JSONPatchOp{Op: "remove", Path: path})
+		default:
+			ops = append(ops, jsonPatchOps(path, newSet.Diff(refSet))...)
+		}
+	}
+	return json.Marshal(ops)
+}
+
+// MergePatch returns the RFC 7386 merge patch turning reference into c.
+func (c *ConfigGroup) MergePatch(reference *ConfigGroup) ([]byte, error) {
+	patch := make(map[string]any)
+	for _, name := range paramSetNames(c, reference) {
+		newSet, newErr := c.ParamSet(name)
+		refSet, refErr := reference.ParamSet(name)
+		switch {
+		case refErr != nil:
+			patch[name] = paramsObject(newSet)
+		case newErr != nil:
+			patch[name] = nil
+		default:
+			if diffs := newSet.Diff(refSet); len(diffs) > 0 {
+				patch[name] = mergePatch(diffs)
+			}
+		}
+	}
+	return json.Marshal(patch)
+}
+
+func jsonPatchOps(pathPrefix string, diffs []Diff) []JSONPatchOp {
+	ops := make([]JSONPatchOp, 0, len(diffs))
+	for _, diff := range diffs {
+		switch d := diff.(type) {
+		case Addition:
+			ops = append(ops, JSONPatchOp{Op: "add", Path: pathPrefix + "/" + escapeJSONPointer(d.Key), Value: d.Value})
+		case Replace:
+			ops = append(ops, JSONPatchOp{Op: "replace", Path: pathPrefix + "/" + escapeJSONPointer(d.Key), Value: d.New})
+		case Deletion:
+			ops = append(ops, JSONPatchOp{Op: "remove", Path: pathPrefix + "/" + escapeJSONPointer(d.Key)})
+		}
+	}
+	return ops
+}
+
+func mergePatch(diffs []Diff) map[string]any {
+	patch := make(map[string]any, len(diffs))
+	for _, diff := range diffs {
+		key := diff.Diff()["key"]
+		if value, exists := diffResult(diff); exists {
+			patch[key] = value
+		} else {
+			patch[key] = nil
+		}
+	}
+	return patch
+}
+
+// paramsObject renders the params of an empty param set as {} rather than
+// null, which a merge patch would take for a removal.
+func paramsObject(paramSet NamedParamSet) map[string]string {
+	if paramSet.params == nil {
+		return map[string]string{}
+	}
+	return paramSet.params
+}
+
+func paramSetNames(groups ...*ConfigGroup) []string {
+	names := make([]string, 0)
+	for _, group := range groups {
+		for _, ps := range group.paramSets {
+			if !slices.Contains(names, ps.name) {
+				names = append(names, ps.name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+const unifiedContextLines = 3
+
+// UnifiedDiff returns a unified diff of two texts, compared line by line.
+func UnifiedDiff(fromName, toName, from, to string) string {
+	a := splitLines(from)
+	b := splitLines(to)
+	edits := lineEdits(a, b)
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", fromName, toName)
+	for start := 0; start < len(edits); {
+		for start < len(edits) && edits[start].op == ' ' {
+			start++
+		}
+		if start == len(edits) {
+			break
+		}
+		hunkStart := max(start-unifiedContextLines, 0)
+		hunkEnd := start
+		for i := start; i < len(edits); i++ {
+			if edits[i].op != ' ' {
+				hunkEnd = i + 1
+			} else if i-hunkEnd >= 2*unifiedContextLines {
+				break
+			}
+		}
+		hunkEnd = min(hunkEnd+unifiedContextLines, len(edits))
+
+		aStart, aLen, bStart, bLen := edits[hunkStart].aLine, 0, edits[hunkStart].bLine, 0
+		for _, edit := range edits[hunkStart:hunkEnd] {
+			if edit.op != '+' {
+				aLen++
+			}
+			if edit.op != '-' {
+				bLen++
+			}
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(aStart, aLen), hunkRange(bStart, bLen))
+		for _, edit := range edits[hunkStart:hunkEnd] {
+			fmt.Fprintf(&out, "%c%s\n", edit.op, edit.line)
+		}
+		start = hunkEnd
+	}
+	return out.String()
+}
+
+type lineEdit struct {
+	op    byte
+	line  string
+	aLine int
+	bLine int
+}
+
+// lineEdits computes a shortest edit script through the longest common
+// subsequence of a and b. Config documents are small enough for O(n*m).
+func lineEdits(a, b []string) []lineEdit {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	edits := make([]lineEdit, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			edits = append(edits, lineEdit{op: ' ', line: a[i], aLine: i + 1, bLine: j + 1})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			edits = append(edits, lineEdit{op: '-', line: a[i], aLine: i + 1, bLine: j + 1})
+			i++
+		default:
+			edits = append(edits, lineEdit{op: '+', line: b[j], aLine: i + 1, bLine: j + 1})
+			j++
+		}
+	}
+	return edits
+}
+
+func hunkRange(start, length int) string {
+	if length == 0 {
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if length == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, length)
+}
+
+func splitLines(text string) []string {
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return []string{}
+	}
+	return strings.Split(text, "\n")
+}
+
+const (
+	PatchOpSet   = "set"
+	PatchOpUnset = "unset"
+)
+
+// PatchOp sets or unsets a single param. ParamSet is only used for config
+// groups, where unsetting an empty key removes the whole param set.
+type PatchOp struct {
+	Op       string
+	ParamSet string
+	Key      string
+	Value    string
+}
+
+func (c *StandaloneConfig) Patch(version string, ops []PatchOp, jsonPatch []byte) (*StandaloneConfig, *Error) {
+	doc := make(map[string]any, len(c.paramSet.params))
+	for key, value := range c.paramSet.params {
+		doc[key] = value
+	}
+	for _, op := range ops {
+		switch op.Op {
+		case PatchOpSet:
+			doc[op.Key] = op.Value
+		case PatchOpUnset:
+			delete(doc, op.Key)
+		default:
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unknown patch operation: %s", op.Op))
+		}
+	}
+	doc, err := applyJSONPatch(doc, jsonPatch)
+	if err != nil {
+		return nil, err
+	}
+	params, err := docToParams(doc, "")
+	if err != nil {
+		return nil, err
+	}
+	patched := NewStandaloneConfig(c.org, c.namespace, version, NamedParamSet{name: c.paramSet.name, params: params})
+	patched.SetLabels(c.labels)
+	return patched, nil
+}
+
+func (c *ConfigGroup) Patch(version string, ops []PatchOp, jsonPatch []byte) (*ConfigGroup, *Error) {
+	doc := make(map[string]any, len(c.paramSets))
+	for _, ps := range c.paramSets {
+		params := make(map[string]any, len(ps.params))
+		for key, value := range ps.params {
+			params[key] = value
+		}
+		doc[ps.name] = params
+	}
+	for _, op := range ops {
+		params, ok := doc[op.ParamSet].(map[string]any)
+		switch {
+		case op.Op == PatchOpSet:
+			if !ok {
+				params = make(map[string]any)
+				doc[op.ParamSet] = params
+			}
+			params[op.Key] = op.Value
+		case op.Op == PatchOpUnset && op.Key == "":
+			delete(doc, op.ParamSet)
+		case op.Op == PatchOpUnset:
+			if ok {
+				delete(params, op.Key)
+			}
+		default:
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unknown patch operation: %s", op.Op))
+		}
+	}
+	doc, err := applyJSONPatch(doc, jsonPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	paramSets := make([]NamedParamSet, 0, len(doc))
+	for _, ps := range c.paramSets {
+		if _, ok := doc[ps.name]; ok {
+			paramSets = append(paramSets, NamedParamSet{name: ps.name})
+		}
+	}
+	added := make([]string, 0)
+	for name := range doc {
+		if !slices.ContainsFunc(paramSets, func(ps NamedParamSet) bool { return ps.name == name }) {
+			added = append(added, name)
+		}
+	}
+	slices.Sort(added)
+	for _, name := range added {
+		paramSets = append(paramSets, NamedParamSet{name: name})
+	}
+	for i := range paramSets {
+		paramsDoc, ok := doc[paramSets[i].name].(map[string]any)
+		if !ok {
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param set %s must be an object", paramSets[i].name))
+		}
+		params, err := docToParams(paramsDoc, "/"+escapeJSONPointer(paramSets[i].name))
+		if err != nil {
+			return nil, err
+		}
+		paramSets[i].params = params
+	}
+	patched := NewConfigGroup(c.org, c.namespace, c.name, version, paramSets)
+	patched.SetLabels(c.labels)
+	return patched, nil
+}
+
+func docToParams(doc map[string]any, path string) (map[string]string, *Error) {
+	params := make(map[string]string, len(doc))
+	for key, value := range doc {
+		str, ok := value.(string)
+		if !ok {
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param %s/%s must be a string", path, escapeJSONPointer(key)))
+		}
+		params[key] = str
+	}
+	return params, nil
+}
+
+// applyJSONPatch applies an RFC 6902 patch to a document made of nested
+// objects with string leaves, as configs are rendered for JSON Patch.
+func applyJSONPatch(doc map[string]any, patch []byte) (map[string]any, *Error) {
+	if len(patch) == 0 {
+		return doc, nil
+	}
+	ops := make([]JSONPatchOp, 0)
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("invalid json patch: %s", err))
+	}
+	for i, op := range ops {
+		var err error
+		if op.takesValue() && !op.hasValue {
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("json patch operation %d: %s requires a value", i, op.Op))
+		}
+		switch op.Op {
+		case "add", "replace":
+			if op.Op == "replace" {
+				if _, err = pointerGet(doc, op.Path); err != nil {
+					break
+				}
+			}
+			err = pointerSet(doc, op.Path, op.Value)
+		case "remove":
+			err = pointerRemove(doc, op.Path)
+		case "move", "copy":
+			var value any
+			value, err = pointerGet(doc, op.From)
+			if err != nil {
+				break
+			}
+			if op.Op == "move" {
+				if err = pointerRemove(doc, op.From); err != nil {
+					break
+				}
+			}
+			err = pointerSet(doc, op.Path, value)
+		case "test":
+			var value any
+			value, err = pointerGet(doc, op.Path)
+			if err == nil && !jsonEqual(value, op.Value) {
+				err = fmt.Errorf("value at %s does not match", op.Path)
+			}
+		default:
+			err = fmt.Errorf("unknown operation %q", op.Op)
+		}
+		if err != nil {
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("json patch operation %d: %s", i, err))
+		}
+	}
+	return doc, nil
+}
+
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, errors.New("replacing the whole document is not supported")
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid pointer %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func pointerParent(doc map[string]any, pointer string) (map[string]any, string, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, "", err
+	}
+	parent := doc
+	for _, token := range tokens[:len(tokens)-1] {
+		child, ok := parent[token].(map[string]any)
+		if !ok {
+			return nil, "", fmt.Errorf("path %s does not exist", pointer)
+		}
+		parent = child
+	}
+	return parent, tokens[len(tokens)-1], nil
+}
+
+func pointerGet(doc map[string]any, pointer string) (any, error) {
+	parent, key, err := pointerParent(doc, pointer)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := parent[key]
+	if !ok {
+		return nil, fmt.Errorf("path %s does not exist", pointer)
+	}
+	return value, nil
+}
+
+func pointerSet(doc map[string]any, pointer string, value any) error {
+	parent, key, err := pointerParent(doc, pointer)
+	if err != nil {
+		return err
+	}
+	parent[key] = value
+	return nil
+}
+
+func pointerRemove(doc map[string]any, pointer string) error {
+	parent, key, err := pointerParent(doc, pointer)
+	if err != nil {
+		return err
+	}
+	if _, ok := parent[key]; !ok {
+		return fmt.Errorf("path %s does not exist", pointer)
+	}
+	delete(parent, key)
+	return nil
+}
+
+func jsonEqual(a, b any) bool {
+	aJson, aErr := json.Marshal(a)
+	bJson, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aJson) == string(bJson)
+}
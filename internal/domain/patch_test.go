@@ -0,0 +1,340 @@
+package domain
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestJSONPatch(t *testing.T) {
+	tests := []struct {
+		name string
+		from map[string]string
+		to   map[string]string
+		want string
+	}{
+		{
+			name: "no changes",
+			from: map[string]string{"a": "1"},
+			to:   map[string]string{"a": "1"},
+			want: `[]`,
+		},
+		{
+			name: "add, replace and remove in key order",
+			from: map[string]string{"b": "1", "c": "1"},
+			to:   map[string]string{"a": "1", "b": "2"},
+			want: `[{"op":"add","path":"/a","value":"1"},{"op":"replace","path":"/b","value":"2"},{"op":"remove","path":"/c"}]`,
+		},
+		{
+			name: "empty values are kept",
+			from: map[string]string{},
+			to:   map[string]string{"a": ""},
+			want: `[{"op":"add","path":"/a","value":""}]`,
+		},
+		{
+			name: "keys are escaped as JSON pointers",
+			from: map[string]string{},
+			to:   map[string]string{"a/b~c": "1"},
+			want: `[{"op":"add","path":"/a~1b~0c","value":"1"}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := JSONPatch(NewParamSet("ps", tt.to).Diff(*NewParamSet("ps", tt.from)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(patch) != tt.want {
+				t.Errorf("JSONPatch() = %s, want %s", patch, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		name string
+		from map[string]string
+		to   map[string]string
+		want string
+	}{
+		{
+			name: "no changes",
+			from: map[string]string{"a": "1"},
+			to:   map[string]string{"a": "1"},
+			want: `{}`,
+		},
+		{
+			name: "removals are null",
+			from: map[string]string{"b": "1", "c": "1"},
+			to:   map[string]string{"a": "1", "b": "2"},
+			want: `{"a":"1","b":"2","c":null}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := MergePatch(NewParamSet("ps", tt.to).Diff(*NewParamSet("ps", tt.from)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(patch) != tt.want {
+				t.Errorf("MergePatch() = %s, want %s", patch, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigGroupMergePatch(t *testing.T) {
+	tests := []struct {
+		name string
+		from []NamedParamSet
+		to   []NamedParamSet
+		want string
+	}{
+		{
+			name: "param set added empty isn't taken for a removal",
+			from: []NamedParamSet{},
+			to:   []NamedParamSet{{name: "a"}},
+			want: `{"a":{}}`,
+		},
+		{
+			name: "param set removed",
+			from: []NamedParamSet{{name: "a", params: map[string]string{"k": "v"}}},
+			to:   []NamedParamSet{},
+			want: `{"a":null}`,
+		},
+		{
+			name: "unchanged param sets are left out",
+			from: []NamedParamSet{{name: "a", params: map[string]string{"k": "v"}}, {name: "b", params: map[string]string{"k": "v"}}},
+			to:   []NamedParamSet{{name: "a", params: map[string]string{"k": "v"}}, {name: "b", params: map[string]string{"k": "w"}}},
+			want: `{"b":{"k":"w"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from := NewConfigGroup("org", "ns", "group", "v1", tt.from)
+			to := NewConfigGroup("org", "ns", "group", "v2", tt.to)
+			patch, err := to.MergePatch(from)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(patch) != tt.want {
+				t.Errorf("MergePatch() = %s, want %s", patch, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	numbered := func(changed ...int) string {
+		lines := make([]string, 0, 10)
+		for i := 1; i <= 10; i++ {
+			line := string(rune('0' + i%10))
+			for _, c := range changed {
+				if c == i {
+					line += "'"
+				}
+			}
+			lines = append(lines, line)
+		}
+		return strings.Join(lines, "\n") + "\n"
+	}
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want string
+	}{
+		{
+			name: "identical",
+			from: "a\nb\n",
+			to:   "a\nb\n",
+			want: "--- from\n+++ to\n",
+		},
+		{
+			name: "changed line with context",
+			from: "a\nb\nc\n",
+			to:   "a\nB\nc\n",
+			want: "--- from\n+++ to\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n",
+		},
+		{
+			name: "added to an empty text",
+			from: "",
+			to:   "x\n",
+			want: "--- from\n+++ to\n@@ -0,0 +1 @@\n+x\n",
+		},
+		{
+			name: "missing trailing newline is ignored",
+			from: "a",
+			to:   "a\n",
+			want: "--- from\n+++ to\n",
+		},
+		{
+			name: "distant changes get separate hunks",
+			from: numbered(),
+			to:   numbered(1, 10),
+			want: "--- from\n+++ to\n" +
+				"@@ -1,4 +1,4 @@\n-1\n+1'\n 2\n 3\n 4\n" +
+				"@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-0\n+0'\n",
+		},
+		{
+			name: "close changes share a hunk",
+			from: numbered(),
+			to:   numbered(3, 7),
+			want: "--- from\n+++ to\n" +
+				"@@ -1,10 +1,10 @@\n 1\n 2\n-3\n+3'\n 4\n 5\n 6\n-7\n+7'\n 8\n 9\n 0\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UnifiedDiff("from", "to", tt.from, tt.to)
+			if got != tt.want {
+				t.Errorf("UnifiedDiff() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStandaloneConfigPatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    map[string]string
+		ops       []PatchOp
+		jsonPatch string
+		want      map[string]string
+		invalid   bool
+	}{
+		{
+			name:   "set and unset",
+			params: map[string]string{"a": "1", "b": "1"},
+			ops:    []PatchOp{{Op: PatchOpSet, Key: "a", Value: "2"}, {Op: PatchOpUnset, Key: "b"}, {Op: PatchOpSet, Key: "c", Value: ""}},
+			want:   map[string]string{"a": "2", "c": ""},
+		},
+		{
+			name:    "unknown operation",
+			params:  map[string]string{"a": "1"},
+			ops:     []PatchOp{{Op: "rename", Key: "a"}},
+			invalid: true,
+		},
+		{
+			name:      "json patch after the operations",
+			params:    map[string]string{"a": "1"},
+			ops:       []PatchOp{{Op: PatchOpSet, Key: "b", Value: "1"}},
+			jsonPatch: `[{"op":"test","path":"/b","value":"1"},{"op":"move","from":"/b","path":"/c"},{"op":"copy","from":"/a","path":"/d~1e"}]`,
+			want:      map[string]string{"a": "1", "c": "1", "d/e": "1"},
+		},
+		{
+			name:      "replace of a missing key",
+			params:    map[string]string{"a": "1"},
+			jsonPatch: `[{"op":"replace","path":"/b","value":"1"}]`,
+			invalid:   true,
+		},
+		{
+			name:      "failed test",
+			params:    map[string]string{"a": "1"},
+			jsonPatch: `[{"op":"test","path":"/a","value":"2"}]`,
+			invalid:   true,
+		},
+		{
+			name:      "add without a value",
+			params:    map[string]string{},
+			jsonPatch: `[{"op":"add","path":"/a"}]`,
+			invalid:   true,
+		},
+		{
+			name:      "non-string value",
+			params:    map[string]string{},
+			jsonPatch: `[{"op":"add","path":"/a","value":1}]`,
+			invalid:   true,
+		},
+		{
+			name:      "whole document",
+			params:    map[string]string{},
+			jsonPatch: `[{"op":"add","path":"","value":{}}]`,
+			invalid:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewStandaloneConfig("org", "ns", "v1", *NewParamSet("db", tt.params))
+			patched, err := config.Patch("v2", tt.ops, []byte(tt.jsonPatch))
+			if tt.invalid || err != nil {
+				if !tt.invalid || err == nil || err.ErrType() != ErrTypeSchemaInvalid {
+					t.Fatalf("err = %v, want invalid = %t", err, tt.invalid)
+				}
+				return
+			}
+			if !maps.Equal(patched.ParamSet(), tt.want) {
+				t.Errorf("params = %v, want %v", patched.ParamSet(), tt.want)
+			}
+			if patched.Version() != "v2" || patched.Name() != "db" {
+				t.Errorf("patched %s/%s, want db/v2", patched.Name(), patched.Version())
+			}
+			if !maps.Equal(config.ParamSet(), tt.params) {
+				t.Errorf("original params were modified to %v", config.ParamSet())
+			}
+		})
+	}
+}
+
+func TestConfigGroupPatch(t *testing.T) {
+	group := NewConfigGroup("org", "ns", "group", "v1", []NamedParamSet{
+		{name: "b", params: map[string]string{"k": "1"}},
+		{name: "a", params: map[string]string{"k": "1"}},
+	})
+	tests := []struct {
+		name      string
+		ops       []PatchOp
+		jsonPatch string
+		want      []string
+		invalid   bool
+	}{
+		{
+			name: "existing param sets keep their order, new ones are sorted",
+			ops:  []PatchOp{{Op: PatchOpSet, ParamSet: "d", Key: "k", Value: "1"}, {Op: PatchOpSet, ParamSet: "c", Key: "k", Value: "1"}},
+			want: []string{"b", "a", "c", "d"},
+		},
+		{
+			name: "unsetting an empty key removes the param set",
+			ops:  []PatchOp{{Op: PatchOpUnset, ParamSet: "b"}},
+			want: []string{"a"},
+		},
+		{
+			name: "unsetting a key of a missing param set",
+			ops:  []PatchOp{{Op: PatchOpUnset, ParamSet: "x", Key: "k"}},
+			want: []string{"b", "a"},
+		},
+		{
+			name:      "param sets moved by json patch",
+			jsonPatch: `[{"op":"move","from":"/a","path":"/z"}]`,
+			want:      []string{"b", "z"},
+		},
+		{
+			name:      "param set that isn't an object",
+			jsonPatch: `[{"op":"add","path":"/c","value":"1"}]`,
+			invalid:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patched, err := group.Patch("v2", tt.ops, []byte(tt.jsonPatch))
+			if tt.invalid || err != nil {
+				if !tt.invalid || err == nil || err.ErrType() != ErrTypeSchemaInvalid {
+					t.Fatalf("err = %v, want invalid = %t", err, tt.invalid)
+				}
+				return
+			}
+			names := make([]string, 0)
+			for _, ps := range patched.ParamSets() {
+				names = append(names, ps.Name())
+			}
+			if !slices.Equal(names, tt.want) {
+				t.Errorf("param sets = %v, want %v", names, tt.want)
+			}
+			if len(group.ParamSets()) != 2 {
+				t.Errorf("original group was modified")
+			}
+		})
+	}
+}
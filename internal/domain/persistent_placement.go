@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// PersistentPlacement keeps a config placed on every node its strategy
+// selects, including nodes that join after the placement was made. There is
+// at most one per config, placing a new version replaces it.
+type PersistentPlacement struct {
+	Config ConfigRef
+	// marshalled api.PlaceReq_Strategy
+	Strategy []byte
+	Timeout  time.Duration
+	// send removals to nodes the strategy no longer selects
+	RemoveUnmatched bool
+	CreatedAt       int64
+	// who the placement is reconciled as
+	Identity ServiceIdentity
+}
+
+type PersistentPlacementStore interface {
+	Put(ctx context.Context, placement PersistentPlacement) *Error
+	// Delete removes the persistent placement of the config, regardless of
+	// the version it places.
+	Delete(ctx context.Context, config ConfigRef) (*PersistentPlacement, *Error)
+	// DeleteVersion removes the persistent placement of the config only if it
+	// places config's version, returning nil if it didn't.
+	DeleteVersion(ctx context.Context, config ConfigRef) (*PersistentPlacement, *Error)
+	// DeleteOtherVersion removes the persistent placement of the config only
+	// if it places a version other than config's, returning nil if it didn't.
+	DeleteOtherVersion(ctx context.Context, config ConfigRef) (*PersistentPlacement, *Error)
+	List(ctx context.Context) ([]PersistentPlacement, *Error)
+	ListByOrg(ctx context.Context, org Org) ([]PersistentPlacement, *Error)
+}
@@ -11,6 +11,23 @@ const (
 	PlacementTaskStatusAccepted PlacementTaskStatus = iota
 	PlacementTaskStatusPlaced
 	PlacementTaskStatusFailed
+	// PlacementTaskStatusSuperseded marks a placed task whose config was
+	// replaced on the node by a rollback.
+	PlacementTaskStatusSuperseded
+	// PlacementTaskStatusTimedOut marks a task the agent didn't report on
+	// before its deadline.
+	PlacementTaskStatusTimedOut
+	// PlacementTaskStatusInProgress marks a task the agent is still applying.
+	PlacementTaskStatusInProgress
+	// PlacementTaskStatusRejected marks a task the agent refused to apply,
+	// e.g. because the config isn't valid on the node.
+	PlacementTaskStatusRejected
+	// PlacementTaskStatusCancelled marks a task that was cancelled before the
+	// agent reported on it.
+	PlacementTaskStatusCancelled
+	// PlacementTaskStatusRemoved marks a placed task whose config was removed
+	// from the node because it stopped matching a persistent placement.
+	PlacementTaskStatusRemoved
 )
 
 func (s PlacementTaskStatus) String() string {
@@ -21,17 +38,77 @@ func (s PlacementTaskStatus) String() string {
 		return "Placed"
 	case PlacementTaskStatusFailed:
 		return "Failed"
+	case PlacementTaskStatusSuperseded:
+		return "Superseded"
+	case PlacementTaskStatusTimedOut:
+		return "TimedOut"
+	case PlacementTaskStatusInProgress:
+		return "InProgress"
+	case PlacementTaskStatusRejected:
+		return "Rejected"
+	case PlacementTaskStatusCancelled:
+		return "Cancelled"
+	case PlacementTaskStatusRemoved:
+		return "Removed"
 	default:
 		return "Unknown"
 	}
 }
 
+func GetPlacementTaskStatusValues() []PlacementTaskStatus {
+	return []PlacementTaskStatus{
+		PlacementTaskStatusAccepted,
+		PlacementTaskStatusPlaced,
+		PlacementTaskStatusFailed,
+		PlacementTaskStatusSuperseded,
+		PlacementTaskStatusTimedOut,
+		PlacementTaskStatusInProgress,
+		PlacementTaskStatusRejected,
+		PlacementTaskStatusCancelled,
+		PlacementTaskStatusRemoved,
+	}
+}
+
+// Pending is true while the agent hasn't reported the outcome of the task.
+func (s PlacementTaskStatus) Pending() bool {
+	return s == PlacementTaskStatusAccepted || s == PlacementTaskStatusInProgress
+}
+
+// Succeeded is true if the config was applied on the node, even if it has
+// been replaced or removed since.
+func (s PlacementTaskStatus) Succeeded() bool {
+	return s == PlacementTaskStatusPlaced || s == PlacementTaskStatusSuperseded || s == PlacementTaskStatusRemoved
+}
+
+func PlacementTaskStatusFromString(status string) (PlacementTaskStatus, bool) {
+	for _, s := range GetPlacementTaskStatusValues() {
+		if s.String() == status {
+			return s, true
+		}
+	}
+	return PlacementTaskStatusAccepted, false
+}
+
 type PlacementTask struct {
 	id         string
 	node       Node
 	status     PlacementTaskStatus
 	acceptedAt int64
 	resolvedAt int64
+	rollbackOf string
+	// version of the config the rolled back task placed
+	rollbackOfVersion string
+	supersededBy      string
+	deadline          int64
+	attempts          int
+	reason            string
+	seed              string
+	progress          int32
+	duration          time.Duration
+	placementId       string
+	sequence          int64
+	// when a removal of the config was last sent to the node
+	removalRequestedAt int64
 }
 
 func NewPlacementTask(id string, node Node, status PlacementTaskStatus, acceptedAt, resolvedAt int64) *PlacementTask {
@@ -69,15 +146,239 @@ func (p *PlacementTask) ResolveddAtUTC() time.Time {
 }
 
 func (p *PlacementTask) Resolved() bool {
-	return p.status != PlacementTaskStatusAccepted
+	return !p.status.Pending()
 }
 
 func (p *PlacementTask) Status() PlacementTaskStatus {
 	return p.status
 }
 
+// Resolve records the final status of the task along with why it was reached.
+func (p *PlacementTask) Resolve(status PlacementTaskStatus, reason string, resolvedAt int64) {
+	p.status = status
+	p.reason = reason
+	p.resolvedAt = resolvedAt
+}
+
+// RollbackOf is the id of the task this task rolled back, if any.
+func (p *PlacementTask) RollbackOf() string {
+	return p.rollbackOf
+}
+
+// RollbackOfVersion is the config version placed by the task this task
+// rolled back.
+func (p *PlacementTask) RollbackOfVersion() string {
+	return p.rollbackOfVersion
+}
+
+func (p *PlacementTask) SetRollbackOf(taskId, version string) {
+	p.rollbackOf = taskId
+	p.rollbackOfVersion = version
+}
+
+// SupersededBy is the id of the rollback task that replaced this task's
+// config, set once the rollback task is placed.
+func (p *PlacementTask) SupersededBy() string {
+	return p.supersededBy
+}
+
+func (p *PlacementTask) SetSupersededBy(taskId string) {
+	p.supersededBy = taskId
+}
+
+// Deadline is the unix time by which the agent has to report on the task,
+// zero if the task never times out.
+func (p *PlacementTask) Deadline() int64 {
+	return p.deadline
+}
+
+func (p *PlacementTask) DeadlineUTC() time.Time {
+	return time.Unix(p.deadline, 0).UTC()
+}
+
+func (p *PlacementTask) SetDeadline(deadline int64) {
+	p.deadline = deadline
+}
+
+func (p *PlacementTask) Expired(now time.Time) bool {
+	return p.status.Pending() && p.deadline > 0 && now.Unix() >= p.deadline
+}
+
+// Attempts is the number of times the config was handed to the agent queue.
+func (p *PlacementTask) Attempts() int {
+	return p.attempts
+}
+
+func (p *PlacementTask) SetAttempts(attempts int) {
+	p.attempts = attempts
+}
+
+// Reason explains why the task failed, or why its last delivery did.
+func (p *PlacementTask) Reason() string {
+	return p.reason
+}
+
+func (p *PlacementTask) SetReason(reason string) {
+	p.reason = reason
+}
+
+// Seed is the strategy seed the node was selected with, which makes the
+// selection reproducible.
+func (p *PlacementTask) Seed() string {
+	return p.seed
+}
+
+func (p *PlacementTask) SetSeed(seed string) {
+	p.seed = seed
+}
+
+// Progress is the percentage of the config the agent reported as applied.
+func (p *PlacementTask) Progress() int32 {
+	return p.progress
+}
+
+func (p *PlacementTask) SetProgress(progress int32) {
+	p.progress = progress
+}
+
+// Duration is how long the agent took to apply the config.
+func (p *PlacementTask) Duration() time.Duration {
+	return p.duration
+}
+
+func (p *PlacementTask) SetDuration(duration time.Duration) {
+	p.duration = duration
+}
+
+// PlacementId groups the tasks created by a single Place call.
+func (p *PlacementTask) PlacementId() string {
+	return p.placementId
+}
+
+func (p *PlacementTask) SetPlacementId(placementId string) {
+	p.placementId = placementId
+}
+
+// Sequence increases with every task created, so unlike the accepted time,
+// which only has second resolution, it orders tasks placed within the same
+// second.
+func (p *PlacementTask) Sequence() int64 {
+	return p.sequence
+}
+
+func (p *PlacementTask) SetSequence(sequence int64) {
+	p.sequence = sequence
+}
+
+// RemovalRequestedAt is the unix time a removal of the placed config was last
+// sent to the node, zero if none was. The task is only marked as removed once
+// the agent acknowledges it.
+func (p *PlacementTask) RemovalRequestedAt() int64 {
+	return p.removalRequestedAt
+}
+
+func (p *PlacementTask) SetRemovalRequestedAt(requestedAt int64) {
+	p.removalRequestedAt = requestedAt
+}
+
+// TaskReport is what an agent reported about a task.
+type TaskReport struct {
+	Status   PlacementTaskStatus
+	Reason   string
+	Progress int32
+	Duration time.Duration
+}
+
+type ConfigRef struct {
+	Org       Org
+	Namespace string
+	Name      string
+	Version   string
+	Type      string
+}
+
+// SameConfig is true if both refer to the same config, regardless of version.
+func (r ConfigRef) SameConfig(other ConfigRef) bool {
+	return r.Org == other.Org && r.Type == other.Type && r.Namespace == other.Namespace && r.Name == other.Name
+}
+
+func NewConfigRef(config Config) ConfigRef {
+	return ConfigRef{
+		Org:       config.Org(),
+		Namespace: config.Namespace(),
+		Name:      config.Name(),
+		Version:   config.Version(),
+		Type:      config.Type(),
+	}
+}
+
+type NodePlacement struct {
+	Config ConfigRef
+	Task   PlacementTask
+}
+
+// PlacementPlan describes what placing a config would change on a node.
+type PlacementPlan struct {
+	Node   Node
+	Labels map[string]string
+	// version of the config currently placed on the node, nil if none is
+	Current *ConfigRef
+	// changes the node would receive, keyed by param set name
+	Diffs map[string][]Diff
+}
+
+// PlacementSummary counts the tasks of a placement by status.
+type PlacementSummary struct {
+	Total    int
+	Statuses map[PlacementTaskStatus]int
+	// false if the summary was made before all tasks were resolved
+	Resolved bool
+}
+
+// CancelledPlacement is what cancelling a config's placement stopped.
+type CancelledPlacement struct {
+	Tasks []PlacementTask
+	// active rollouts of the config that were aborted
+	AbortedRollouts []string
+	// pending scheduled placements of the config that were cancelled
+	CancelledSchedules []string
+	// whether the persistent placement of the config's version was deleted
+	PersistentDeleted bool
+}
+
+type RollbackPlacement struct {
+	Superseded NodePlacement
+	Restored   NodePlacement
+}
+
 type PlacementStore interface {
 	Place(ctx context.Context, config Config, req *PlacementTask) *Error
 	ListByConfig(ctx context.Context, org Org, namespace, name, version, configType string) ([]PlacementTask, *Error)
-	UpdateStatus(ctx context.Context, org Org, namespace, name, version, configType, taskId string, status PlacementTaskStatus) *Error
+	ListByNode(ctx context.Context, org Org, node Node) ([]NodePlacement, *Error)
+	ListByOrg(ctx context.Context, org Org) ([]NodePlacement, *Error)
+	// UpdateStatus records the agent's report, failing with ErrTypeConflict if
+	// it reports progress on a task that was already resolved.
+	UpdateStatus(ctx context.Context, org Org, namespace, name, version, configType, taskId string, report TaskReport) *Error
+	Supersede(ctx context.Context, config ConfigRef, taskId, supersededBy string) *Error
+	Get(ctx context.Context, config ConfigRef, taskId string) (*PlacementTask, *Error)
+	ListExpired(ctx context.Context, now time.Time) ([]NodePlacement, *Error)
+	// TimeOut moves a task to PlacementTaskStatusTimedOut, failing with
+	// ErrTypeConflict if the agent has reported on it in the meantime.
+	TimeOut(ctx context.Context, config ConfigRef, taskId string) *Error
+	// RecordDelivery stores the outcome of an attempt to hand the task to the
+	// agent queue, an empty deliveryErr meaning it succeeded.
+	RecordDelivery(ctx context.Context, config ConfigRef, taskId string, attempts int, deliveryErr string) *Error
+	// FailDelivery marks a task that could never be delivered as failed.
+	FailDelivery(ctx context.Context, config ConfigRef, taskId, reason string) *Error
+	// RequestRemoval records that a removal of the config was sent to the
+	// task's node, failing with ErrTypeConflict if the task isn't placed.
+	RequestRemoval(ctx context.Context, config ConfigRef, taskId string) *Error
+	// Cancel moves a task to PlacementTaskStatusCancelled, failing with
+	// ErrTypeConflict if the agent has acknowledged it in the meantime. A
+	// cancelled task is only changed again if the agent reports that it
+	// applied the config anyway.
+	Cancel(ctx context.Context, config ConfigRef, taskId string) *Error
+	// Watch returns the config's tasks along with a channel of every later
+	// change to them, which is closed once ctx is done or the watch fails.
+	Watch(ctx context.Context, config ConfigRef) ([]PlacementTask, <-chan PlacementTask, *Error)
 }
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlacementTaskExpired(t *testing.T) {
+	now := time.Unix(1000, 0)
+	tests := []struct {
+		name     string
+		status   PlacementTaskStatus
+		deadline int64
+		expired  bool
+	}{
+		{name: "before the deadline", status: PlacementTaskStatusAccepted, deadline: 1001},
+		{name: "at the deadline", status: PlacementTaskStatusAccepted, deadline: 1000, expired: true},
+		{name: "in progress past the deadline", status: PlacementTaskStatusInProgress, deadline: 999, expired: true},
+		{name: "resolved past the deadline", status: PlacementTaskStatusPlaced, deadline: 999},
+		{name: "no deadline", status: PlacementTaskStatusAccepted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := NewPlacementTask("t1", "n1", tt.status, 0, 0)
+			task.SetDeadline(tt.deadline)
+			if got := task.Expired(now); got != tt.expired {
+				t.Errorf("Expired() = %t, want %t", got, tt.expired)
+			}
+		})
+	}
+}
+
+func TestPlacementTaskStatus(t *testing.T) {
+	tests := []struct {
+		status    PlacementTaskStatus
+		pending   bool
+		succeeded bool
+	}{
+		{status: PlacementTaskStatusAccepted, pending: true},
+		{status: PlacementTaskStatusInProgress, pending: true},
+		{status: PlacementTaskStatusPlaced, succeeded: true},
+		{status: PlacementTaskStatusSuperseded, succeeded: true},
+		{status: PlacementTaskStatusRemoved, succeeded: true},
+		{status: PlacementTaskStatusFailed},
+		{status: PlacementTaskStatusRejected},
+		{status: PlacementTaskStatusTimedOut},
+		{status: PlacementTaskStatusCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			if got := tt.status.Pending(); got != tt.pending {
+				t.Errorf("Pending() = %t, want %t", got, tt.pending)
+			}
+			if got := tt.status.Succeeded(); got != tt.succeeded {
+				t.Errorf("Succeeded() = %t, want %t", got, tt.succeeded)
+			}
+			if parsed, ok := PlacementTaskStatusFromString(tt.status.String()); !ok || parsed != tt.status {
+				t.Errorf("PlacementTaskStatusFromString(%s) = %s, %t", tt.status, parsed, ok)
+			}
+		})
+	}
+	if len(tests) != len(GetPlacementTaskStatusValues()) {
+		t.Errorf("%d statuses tested, %d exist", len(tests), len(GetPlacementTaskStatusValues()))
+	}
+	if _, ok := PlacementTaskStatusFromString("Unknown"); ok {
+		t.Errorf("Unknown parsed as a status")
+	}
+}
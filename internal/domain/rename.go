@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// renameSimilarityThreshold is the minimal share of identical key-value
+// pairs two param sets must have for one to be considered a rename of the other.
+const renameSimilarityThreshold = 0.5
+
+// Similarity returns the Jaccard index of the key-value pairs of both param sets.
+func (ps NamedParamSet) Similarity(cmp NamedParamSet) float64 {
+	if len(ps.params) == 0 && len(cmp.params) == 0 {
+		return 1
+	}
+	common := 0
+	for key, value := range ps.params {
+		if cmpValue, ok := cmp.params[key]; ok && cmpValue == value {
+			common++
+		}
+	}
+	return float64(common) / float64(len(ps.params)+len(cmp.params)-common)
+}
+
+// Renames pairs param sets that only exist in c with the most similar param
+// sets that only exist in reference. The result maps new names to old ones.
+func (c *ConfigGroup) Renames(reference *ConfigGroup) map[string]string {
+	type candidate struct {
+		to, from   string
+		similarity float64
+	}
+	candidates := make([]candidate, 0)
+	for _, added := range c.paramSets {
+		if _, err := reference.ParamSet(added.name); err == nil {
+			continue
+		}
+		for _, deleted := range reference.paramSets {
+			if _, err := c.ParamSet(deleted.name); err == nil {
+				continue
+			}
+			similarity := added.Similarity(deleted)
+			if similarity >= renameSimilarityThreshold {
+				candidates = append(candidates, candidate{to: added.name, from: deleted.name, similarity: similarity})
+			}
+		}
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		switch {
+		case a.similarity > b.similarity:
+			return -1
+		case a.similarity < b.similarity:
+			return 1
+		case a.to != b.to:
+			return strings.Compare(a.to, b.to)
+		default:
+			return strings.Compare(a.from, b.from)
+		}
+	})
+
+	renames := make(map[string]string)
+	taken := make(map[string]bool)
+	for _, candidate := range candidates {
+		if _, ok := renames[candidate.to]; ok || taken[candidate.from] {
+			continue
+		}
+		renames[candidate.to] = candidate.from
+		taken[candidate.from] = true
+	}
+	return renames
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestParamSetSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a    map[string]string
+		b    map[string]string
+		want float64
+	}{
+		{
+			name: "both empty",
+			a:    map[string]string{},
+			b:    map[string]string{},
+			want: 1,
+		},
+		{
+			name: "one empty",
+			a:    map[string]string{"a": "1"},
+			b:    map[string]string{},
+			want: 0,
+		},
+		{
+			name: "same keys, different values",
+			a:    map[string]string{"a": "1"},
+			b:    map[string]string{"a": "2"},
+			want: 0,
+		},
+		{
+			name: "half shared",
+			a:    map[string]string{"a": "1", "b": "1"},
+			b:    map[string]string{"a": "1", "c": "1"},
+			want: 1.0 / 3,
+		},
+		{
+			name: "subset",
+			a:    map[string]string{"a": "1", "b": "1"},
+			b:    map[string]string{"a": "1", "b": "1", "c": "1", "d": "1"},
+			want: 0.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := NewParamSet("a", tt.a), NewParamSet("b", tt.b)
+			if got := a.Similarity(*b); got != tt.want {
+				t.Errorf("Similarity() = %v, want %v", got, tt.want)
+			}
+			if got := b.Similarity(*a); got != tt.want {
+				t.Errorf("Similarity() isn't symmetric, %v != %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigGroupRenames(t *testing.T) {
+	group := func(paramSets map[string]map[string]string) *ConfigGroup {
+		sets := make([]NamedParamSet, 0, len(paramSets))
+		for name, params := range paramSets {
+			sets = append(sets, *NewParamSet(name, params))
+		}
+		return NewConfigGroup("org", "ns", "group", "v1", sets)
+	}
+	tests := []struct {
+		name      string
+		reference map[string]map[string]string
+		group     map[string]map[string]string
+		want      map[string]string
+	}{
+		{
+			name:      "unchanged param set isn't a rename",
+			reference: map[string]map[string]string{"a": {"k": "1"}},
+			group:     map[string]map[string]string{"a": {"k": "1"}, "b": {"k": "1"}},
+			want:      map[string]string{},
+		},
+		{
+			name:      "renamed with the same params",
+			reference: map[string]map[string]string{"a": {"k": "1"}},
+			group:     map[string]map[string]string{"b": {"k": "1"}},
+			want:      map[string]string{"b": "a"},
+		},
+		{
+			name:      "renamed with changes at the threshold",
+			reference: map[string]map[string]string{"a": {"k": "1", "l": "1"}},
+			group:     map[string]map[string]string{"b": {"k": "1", "l": "1", "m": "1", "n": "1"}},
+			want:      map[string]string{"b": "a"},
+		},
+		{
+			name:      "too different",
+			reference: map[string]map[string]string{"a": {"k": "1", "l": "1"}},
+			group:     map[string]map[string]string{"b": {"k": "1", "l": "2"}},
+			want:      map[string]string{},
+		},
+		{
+			name:      "most similar pairs win",
+			reference: map[string]map[string]string{"a": {"k": "1", "l": "1"}, "b": {"k": "1", "l": "2"}},
+			group:     map[string]map[string]string{"c": {"k": "1", "l": "2"}, "d": {"k": "1", "l": "1"}},
+			want:      map[string]string{"c": "b", "d": "a"},
+		},
+		{
+			name:      "a deleted param set is renamed once",
+			reference: map[string]map[string]string{"a": {"k": "1"}},
+			group:     map[string]map[string]string{"b": {"k": "1"}, "c": {"k": "1"}},
+			want:      map[string]string{"b": "a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := group(tt.group).Renames(group(tt.reference))
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("Renames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigGroupDiffRename(t *testing.T) {
+	reference := NewConfigGroup("org", "ns", "group", "v1", []NamedParamSet{
+		*NewParamSet("db", map[string]string{"host": "a", "port": "1", "user": "u"}),
+		*NewParamSet("cache", map[string]string{"size": "1"}),
+	})
+	group := NewConfigGroup("org", "ns", "group", "v2", []NamedParamSet{
+		*NewParamSet("database", map[string]string{"host": "a", "port": "1", "user": "v"}),
+		*NewParamSet("queue", map[string]string{"size": "2"}),
+	})
+
+	diffs := group.Diff(reference)
+	stats := DiffStats{}
+	for _, paramSetDiffs := range diffs {
+		stats = stats.Add(CountDiffs(paramSetDiffs))
+	}
+	want := DiffStats{Additions: 1, Replacements: 1, Deletions: 1, Renames: 1}
+	if stats != want {
+		t.Errorf("stats = %+v, want %+v", stats, want)
+	}
+	if rename, ok := diffs["database"][0].(Rename); !ok || rename.From != "db" {
+		t.Errorf("database diffs = %v, want a rename from db first", diffs["database"])
+	}
+
+	patch, err := group.JSONPatch(reference)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantPatch := `[{"op":"remove","path":"/cache"},{"op":"move","from":"/db","path":"/database"},{"op":"replace","path":"/database/user","value":"v"},{"op":"add","path":"/queue","value":{"size":"2"}}]`
+	if string(patch) != wantPatch {
+		t.Errorf("JSONPatch() = %s, want %s", patch, wantPatch)
+	}
+	patched, patchErr := reference.Patch("v2", nil, patch)
+	if patchErr != nil {
+		t.Fatal(patchErr)
+	}
+	if patched.ContentHash() != group.ContentHash() {
+		t.Errorf("applying the patch to the reference doesn't give the group back")
+	}
+}
@@ -0,0 +1,274 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+type RolloutStatus string
+
+const (
+	RolloutStatusRunning   RolloutStatus = "running"
+	RolloutStatusPaused    RolloutStatus = "paused"
+	RolloutStatusCompleted RolloutStatus = "completed"
+	RolloutStatusAborted   RolloutStatus = "aborted"
+	RolloutStatusFailed    RolloutStatus = "failed"
+)
+
+func (s RolloutStatus) Terminal() bool {
+	return s == RolloutStatusCompleted || s == RolloutStatusAborted || s == RolloutStatusFailed
+}
+
+type RolloutKind string
+
+const (
+	// RolloutKindCanary pauses when a stage fails on too many of its nodes.
+	RolloutKindCanary RolloutKind = "canary"
+	// RolloutKindRolling stops once failures across all batches exceed MaxFailures.
+	RolloutKindRolling RolloutKind = "rolling"
+)
+
+var DefaultCanaryStages = []int32{1, 10, 50, 100}
+
+// DefaultCanaryMaxFailureRatio is used by canary rollouts that don't set a
+// ratio, so that a single failed node doesn't pause a large stage.
+const DefaultCanaryMaxFailureRatio = 0.1
+
+type RolloutSpec struct {
+	Kind RolloutKind
+	// canary
+	Percentages     []int32
+	MaxFailureRatio float64
+	// rolling, either BatchSize or BatchPercentage is set
+	BatchSize       int
+	BatchPercentage int32
+	MaxFailures     int
+	// how long agents have to report on their tasks, zero for the default
+	Timeout time.Duration
+}
+
+// Stages returns the cumulative number of nodes reached after each stage.
+func (spec RolloutSpec) Stages(nodes int) []int {
+	if spec.Kind == RolloutKindRolling {
+		return RollingStages(nodes, spec.BatchSize, spec.BatchPercentage)
+	}
+	percentages := spec.Percentages
+	if len(percentages) == 0 {
+		percentages = DefaultCanaryStages
+	}
+	return CanaryStages(nodes, percentages)
+}
+
+// FailureRatio returns the share of failed tasks in a canary stage above
+// which the rollout is paused.
+func (spec RolloutSpec) FailureRatio() float64 {
+	if spec.MaxFailureRatio == 0 {
+		return DefaultCanaryMaxFailureRatio
+	}
+	return spec.MaxFailureRatio
+}
+
+type RolloutTask struct {
+	Node   Node
+	TaskId string
+	Stage  int
+	Status PlacementTaskStatus
+}
+
+// Rollout places a config on a fixed, ordered set of nodes in stages. Each
+// stage is only started once the tasks of the previous one resolved, the
+// Kind decides how failed tasks stop it.
+type Rollout struct {
+	Id       string
+	Kind     RolloutKind
+	Config   ConfigRef
+	Strategy string
+	Seed     string
+	Nodes    []Node
+	// cumulative number of nodes the config is placed on after each stage
+	Stages          []int
+	MaxFailureRatio float64
+	MaxFailures     int
+	Timeout         time.Duration
+	CurrentStage    int
+	// number of nodes from the start of Nodes the config was sent to
+	Dispatched int
+	Tasks      []RolloutTask
+	Status     RolloutStatus
+	Reason     string
+	CreatedAt  int64
+	UpdatedAt  int64
+	// etcd mod revision the rollout was read at, used for optimistic updates
+	Revision int64
+}
+
+// CanaryStages converts cumulative percentages into cumulative node counts,
+// making sure every stage reaches at least one new node.
+func CanaryStages(nodes int, percentages []int32) []int {
+	stages := make([]int, 0, len(percentages))
+	prev := 0
+	for _, percentage := range percentages {
+		target := int(math.Ceil(float64(nodes) * float64(percentage) / 100))
+		target = min(max(target, prev+1), nodes)
+		if target == prev {
+			continue
+		}
+		stages = append(stages, target)
+		prev = target
+	}
+	return stages
+}
+
+// RollingStages splits the nodes into batches of batchSize nodes, or of
+// batchPercentage percent of them if batchSize isn't set.
+func RollingStages(nodes, batchSize int, batchPercentage int32) []int {
+	if batchSize <= 0 {
+		batchSize = int(math.Ceil(float64(nodes) * float64(batchPercentage) / 100))
+	}
+	batchSize = max(batchSize, 1)
+	stages := make([]int, 0, nodes/batchSize+1)
+	for target := batchSize; ; target += batchSize {
+		stages = append(stages, min(target, nodes))
+		if target >= nodes {
+			return stages
+		}
+	}
+}
+
+func (r *Rollout) LastStage() bool {
+	return r.CurrentStage == len(r.Stages)-1
+}
+
+// PendingNodes returns the nodes of the current stage the config wasn't sent to yet.
+func (r *Rollout) PendingNodes() []Node {
+	if r.CurrentStage >= len(r.Stages) || r.Dispatched >= r.Stages[r.CurrentStage] {
+		return nil
+	}
+	return r.Nodes[r.Dispatched:r.Stages[r.CurrentStage]]
+}
+
+// Failures counts the failed tasks of all stages.
+func (r *Rollout) Failures() int {
+	failed := 0
+	for _, task := range r.Tasks {
+		if !task.Status.Pending() && !task.Status.Succeeded() {
+			failed++
+		}
+	}
+	return failed
+}
+
+// StageResult counts the tasks of the current stage. done is false while
+// some of them are still waiting for the agents.
+func (r *Rollout) StageResult() (total, failed int, done bool) {
+	done = true
+	for _, task := range r.Tasks {
+		if task.Stage != r.CurrentStage {
+			continue
+		}
+		total++
+		switch {
+		case task.Status.Pending():
+			done = false
+		case !task.Status.Succeeded():
+			failed++
+		}
+	}
+	return total, failed, done
+}
+
+// Advance evaluates the health gate of the current stage once all of its
+// tasks resolved and moves the rollout forward or pauses it.
+func (r *Rollout) Advance() bool {
+	if r.Status != RolloutStatusRunning {
+		return false
+	}
+	if r.Kind == RolloutKindRolling {
+		// a rolling rollout stops as soon as the threshold is crossed,
+		// without waiting for the rest of the batch
+		if failures := r.Failures(); failures > r.MaxFailures {
+			r.Status = RolloutStatusFailed
+			r.Reason = fmt.Sprintf("%d tasks failed, over the allowed %d", failures, r.MaxFailures)
+			return true
+		}
+	}
+	if len(r.PendingNodes()) > 0 {
+		return false
+	}
+	total, failed, done := r.StageResult()
+	if !done {
+		return false
+	}
+	if r.Kind == RolloutKindCanary && total > 0 && float64(failed)/float64(total) > r.MaxFailureRatio {
+		r.Status = RolloutStatusPaused
+		r.Reason = fmt.Sprintf("stage %d failed on %d of %d nodes, over the allowed ratio of %.2f", r.CurrentStage+1, failed, total, r.MaxFailureRatio)
+		return true
+	}
+	r.nextStage()
+	return true
+}
+
+func (r *Rollout) nextStage() {
+	if r.LastStage() {
+		r.Status = RolloutStatusCompleted
+		r.Reason = ""
+		return
+	}
+	r.CurrentStage++
+}
+
+func (r *Rollout) Pause(reason string) *Error {
+	if r.Status != RolloutStatusRunning {
+		return NewError(ErrTypeConflict, fmt.Sprintf("rollout is %s, only running rollouts can be paused", r.Status))
+	}
+	r.Status = RolloutStatusPaused
+	r.Reason = reason
+	return nil
+}
+
+func (r *Rollout) Resume() *Error {
+	if r.Status != RolloutStatusPaused {
+		return NewError(ErrTypeConflict, fmt.Sprintf("rollout is %s, only paused rollouts can be resumed", r.Status))
+	}
+	r.Status = RolloutStatusRunning
+	r.Reason = ""
+	return nil
+}
+
+// Promote skips the health gate of the current stage, or of all remaining
+// stages if full is set, and resumes the rollout.
+func (r *Rollout) Promote(full bool) *Error {
+	if r.Status.Terminal() {
+		return NewError(ErrTypeConflict, fmt.Sprintf("rollout is already %s", r.Status))
+	}
+	r.Status = RolloutStatusRunning
+	r.Reason = ""
+	if full {
+		r.CurrentStage = len(r.Stages) - 1
+		return nil
+	}
+	if len(r.PendingNodes()) == 0 {
+		r.nextStage()
+	}
+	return nil
+}
+
+func (r *Rollout) Abort(reason string) *Error {
+	if r.Status.Terminal() {
+		return NewError(ErrTypeConflict, fmt.Sprintf("rollout is already %s", r.Status))
+	}
+	r.Status = RolloutStatusAborted
+	r.Reason = reason
+	return nil
+}
+
+type RolloutStore interface {
+	Create(ctx context.Context, rollout *Rollout) *Error
+	Get(ctx context.Context, org Org, id string) (*Rollout, *Error)
+	// Update fails with ErrTypeConflict if the rollout changed since it was read.
+	Update(ctx context.Context, rollout *Rollout) *Error
+	ListActive(ctx context.Context) ([]*Rollout, *Error)
+	ListByOrg(ctx context.Context, org Org) ([]*Rollout, *Error)
+}
@@ -0,0 +1,348 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCanaryStages(t *testing.T) {
+	tests := []struct {
+		name        string
+		nodes       int
+		percentages []int32
+		want        []int
+	}{
+		{
+			name:        "default stages",
+			nodes:       100,
+			percentages: DefaultCanaryStages,
+			want:        []int{1, 10, 50, 100},
+		},
+		{
+			name:        "every stage reaches a new node",
+			nodes:       10,
+			percentages: DefaultCanaryStages,
+			want:        []int{1, 2, 5, 10},
+		},
+		{
+			name:        "stages without new nodes are dropped",
+			nodes:       3,
+			percentages: DefaultCanaryStages,
+			want:        []int{1, 2, 3},
+		},
+		{
+			name:        "single node",
+			nodes:       1,
+			percentages: DefaultCanaryStages,
+			want:        []int{1},
+		},
+		{
+			name:        "repeated percentage",
+			nodes:       4,
+			percentages: []int32{50, 50, 100},
+			want:        []int{2, 3, 4},
+		},
+		{
+			name:        "no nodes",
+			nodes:       0,
+			percentages: DefaultCanaryStages,
+			want:        []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CanaryStages(tt.nodes, tt.percentages)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("CanaryStages() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRollingStages(t *testing.T) {
+	tests := []struct {
+		name            string
+		nodes           int
+		batchSize       int
+		batchPercentage int32
+		want            []int
+	}{
+		{
+			name:      "last batch is smaller",
+			nodes:     10,
+			batchSize: 3,
+			want:      []int{3, 6, 9, 10},
+		},
+		{
+			name:            "percentage rounds up",
+			nodes:           10,
+			batchPercentage: 25,
+			want:            []int{3, 6, 9, 10},
+		},
+		{
+			name:            "size takes precedence over percentage",
+			nodes:           4,
+			batchSize:       2,
+			batchPercentage: 100,
+			want:            []int{2, 4},
+		},
+		{
+			name:  "at least one node per batch",
+			nodes: 3,
+			want:  []int{1, 2, 3},
+		},
+		{
+			name:      "batch larger than the nodes",
+			nodes:     5,
+			batchSize: 10,
+			want:      []int{5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RollingStages(tt.nodes, tt.batchSize, tt.batchPercentage)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("RollingStages() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRolloutSpec(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   RolloutSpec
+		stages []int
+		ratio  float64
+	}{
+		{
+			name:   "canary defaults",
+			spec:   RolloutSpec{Kind: RolloutKindCanary},
+			stages: []int{1, 2, 5, 10},
+			ratio:  DefaultCanaryMaxFailureRatio,
+		},
+		{
+			name:   "canary with custom stages",
+			spec:   RolloutSpec{Kind: RolloutKindCanary, Percentages: []int32{50, 100}, MaxFailureRatio: 0.5},
+			stages: []int{5, 10},
+			ratio:  0.5,
+		},
+		{
+			name:   "rolling",
+			spec:   RolloutSpec{Kind: RolloutKindRolling, BatchSize: 4},
+			stages: []int{4, 8, 10},
+			ratio:  DefaultCanaryMaxFailureRatio,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.spec.Stages(10); !slices.Equal(got, tt.stages) {
+				t.Errorf("Stages() = %v, want %v", got, tt.stages)
+			}
+			if got := tt.spec.FailureRatio(); got != tt.ratio {
+				t.Errorf("FailureRatio() = %v, want %v", got, tt.ratio)
+			}
+		})
+	}
+}
+
+func TestRolloutAdvance(t *testing.T) {
+	placed, failed, pending := PlacementTaskStatusPlaced, PlacementTaskStatusFailed, PlacementTaskStatusAccepted
+	// rollout over four nodes, one in the first stage and three in the second
+	rollout := func(kind RolloutKind, stage, dispatched int, statuses ...PlacementTaskStatus) *Rollout {
+		r := &Rollout{
+			Kind:            kind,
+			Nodes:           []Node{"n1", "n2", "n3", "n4"},
+			Stages:          []int{1, 4},
+			MaxFailureRatio: 0.5,
+			MaxFailures:     1,
+			CurrentStage:    stage,
+			Dispatched:      dispatched,
+			Status:          RolloutStatusRunning,
+		}
+		for i, status := range statuses {
+			taskStage := 0
+			if i >= r.Stages[0] {
+				taskStage = 1
+			}
+			r.Tasks = append(r.Tasks, RolloutTask{Node: r.Nodes[i], Stage: taskStage, Status: status})
+		}
+		return r
+	}
+	tests := []struct {
+		name    string
+		rollout *Rollout
+		changed bool
+		status  RolloutStatus
+		stage   int
+	}{
+		{
+			name:    "stage not dispatched yet",
+			rollout: rollout(RolloutKindCanary, 0, 0),
+			status:  RolloutStatusRunning,
+		},
+		{
+			name:    "waiting for the agents",
+			rollout: rollout(RolloutKindCanary, 0, 1, pending),
+			status:  RolloutStatusRunning,
+		},
+		{
+			name:    "healthy stage moves on",
+			rollout: rollout(RolloutKindCanary, 0, 1, placed),
+			changed: true,
+			status:  RolloutStatusRunning,
+			stage:   1,
+		},
+		{
+			name:    "canary pauses over the failure ratio",
+			rollout: rollout(RolloutKindCanary, 0, 1, failed),
+			changed: true,
+			status:  RolloutStatusPaused,
+		},
+		{
+			name:    "canary failures of earlier stages don't count",
+			rollout: rollout(RolloutKindCanary, 1, 4, failed, placed, placed, failed),
+			changed: true,
+			status:  RolloutStatusCompleted,
+			stage:   1,
+		},
+		{
+			name:    "canary over the failure ratio in the last stage",
+			rollout: rollout(RolloutKindCanary, 1, 4, placed, placed, failed, failed),
+			changed: true,
+			status:  RolloutStatusPaused,
+			stage:   1,
+		},
+		{
+			name:    "rolling fails without waiting for the batch",
+			rollout: rollout(RolloutKindRolling, 1, 4, failed, failed, pending, pending),
+			changed: true,
+			status:  RolloutStatusFailed,
+			stage:   1,
+		},
+		{
+			name:    "rolling within the allowed failures",
+			rollout: rollout(RolloutKindRolling, 0, 1, failed),
+			changed: true,
+			status:  RolloutStatusRunning,
+			stage:   1,
+		},
+		{
+			name: "paused rollout doesn't move",
+			rollout: func() *Rollout {
+				r := rollout(RolloutKindCanary, 0, 1, placed)
+				r.Status = RolloutStatusPaused
+				return r
+			}(),
+			status: RolloutStatusPaused,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := tt.rollout.Advance()
+			if changed != tt.changed {
+				t.Errorf("Advance() = %t, want %t", changed, tt.changed)
+			}
+			if tt.rollout.Status != tt.status || tt.rollout.CurrentStage != tt.stage {
+				t.Errorf("rollout is %s at stage %d, want %s at stage %d", tt.rollout.Status, tt.rollout.CurrentStage, tt.status, tt.stage)
+			}
+		})
+	}
+}
+
+func TestRolloutTransitions(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     RolloutStatus
+		dispatched int
+		transition func(r *Rollout) *Error
+		conflict   bool
+		want       RolloutStatus
+		stage      int
+	}{
+		{
+			name:       "pause",
+			status:     RolloutStatusRunning,
+			transition: func(r *Rollout) *Error { return r.Pause("by user") },
+			want:       RolloutStatusPaused,
+		},
+		{
+			name:       "pause paused",
+			status:     RolloutStatusPaused,
+			transition: func(r *Rollout) *Error { return r.Pause("by user") },
+			conflict:   true,
+			want:       RolloutStatusPaused,
+		},
+		{
+			name:       "resume",
+			status:     RolloutStatusPaused,
+			transition: func(r *Rollout) *Error { return r.Resume() },
+			want:       RolloutStatusRunning,
+		},
+		{
+			name:       "resume running",
+			status:     RolloutStatusRunning,
+			transition: func(r *Rollout) *Error { return r.Resume() },
+			conflict:   true,
+			want:       RolloutStatusRunning,
+		},
+		{
+			name:       "promote dispatched stage",
+			status:     RolloutStatusPaused,
+			dispatched: 1,
+			transition: func(r *Rollout) *Error { return r.Promote(false) },
+			want:       RolloutStatusRunning,
+			stage:      1,
+		},
+		{
+			name:       "promote stage still being dispatched",
+			status:     RolloutStatusPaused,
+			transition: func(r *Rollout) *Error { return r.Promote(false) },
+			want:       RolloutStatusRunning,
+		},
+		{
+			name:       "full promotion",
+			status:     RolloutStatusPaused,
+			transition: func(r *Rollout) *Error { return r.Promote(true) },
+			want:       RolloutStatusRunning,
+			stage:      2,
+		},
+		{
+			name:       "promote completed",
+			status:     RolloutStatusCompleted,
+			transition: func(r *Rollout) *Error { return r.Promote(false) },
+			conflict:   true,
+			want:       RolloutStatusCompleted,
+		},
+		{
+			name:       "abort paused",
+			status:     RolloutStatusPaused,
+			transition: func(r *Rollout) *Error { return r.Abort("by user") },
+			want:       RolloutStatusAborted,
+		},
+		{
+			name:       "abort failed",
+			status:     RolloutStatusFailed,
+			transition: func(r *Rollout) *Error { return r.Abort("by user") },
+			conflict:   true,
+			want:       RolloutStatusFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Rollout{
+				Nodes:      []Node{"n1", "n2", "n3"},
+				Stages:     []int{1, 2, 3},
+				Dispatched: tt.dispatched,
+				Status:     tt.status,
+			}
+			err := tt.transition(r)
+			if (err != nil) != tt.conflict || (err != nil && err.ErrType() != ErrTypeConflict) {
+				t.Errorf("err = %v, want conflict = %t", err, tt.conflict)
+			}
+			if r.Status != tt.want || r.CurrentStage != tt.stage {
+				t.Errorf("rollout is %s at stage %d, want %s at stage %d", r.Status, r.CurrentStage, tt.want, tt.stage)
+			}
+		})
+	}
+}
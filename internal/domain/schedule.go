@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type ScheduledPlacementStatus string
+
+const (
+	ScheduledPlacementStatusPending   ScheduledPlacementStatus = "pending"
+	ScheduledPlacementStatusRunning   ScheduledPlacementStatus = "running"
+	ScheduledPlacementStatusCompleted ScheduledPlacementStatus = "completed"
+	ScheduledPlacementStatusFailed    ScheduledPlacementStatus = "failed"
+	ScheduledPlacementStatusCancelled ScheduledPlacementStatus = "cancelled"
+)
+
+// ScheduledPlacement is a placement request that is executed in the
+// background once StartAt passes.
+type ScheduledPlacement struct {
+	Id     string
+	Config ConfigRef
+	// marshalled api.PlaceReq
+	Request []byte
+	StartAt int64
+	Status  ScheduledPlacementStatus
+	Reason  string
+	// outcome of the placement
+	TaskIds    []string
+	RolloutId  string
+	CreatedAt  int64
+	StartedAt  int64
+	ExecutedAt int64
+	// who requested the placement, it's executed with their permissions
+	Identity ServiceIdentity
+	// etcd mod revision the placement was read at, used for optimistic updates
+	Revision int64
+}
+
+func (p *ScheduledPlacement) StartAtUTC() time.Time {
+	return time.Unix(p.StartAt, 0).UTC()
+}
+
+func (p *ScheduledPlacement) Due(now time.Time) bool {
+	return p.Status == ScheduledPlacementStatusPending && now.Unix() >= p.StartAt
+}
+
+// Stuck is true if the placement started running before startedBefore and
+// still hasn't finished, e.g. because the instance running it stopped.
+func (p *ScheduledPlacement) Stuck(startedBefore time.Time) bool {
+	return p.Status == ScheduledPlacementStatusRunning && p.StartedAt < startedBefore.Unix()
+}
+
+func (p *ScheduledPlacement) Cancel(reason string) *Error {
+	if p.Status != ScheduledPlacementStatusPending {
+		return NewError(ErrTypeConflict, fmt.Sprintf("scheduled placement (id=%s) is %s", p.Id, p.Status))
+	}
+	p.Status = ScheduledPlacementStatusCancelled
+	p.Reason = reason
+	return nil
+}
+
+type ScheduledPlacementStore interface {
+	Create(ctx context.Context, placement *ScheduledPlacement) *Error
+	Get(ctx context.Context, org Org, id string) (*ScheduledPlacement, *Error)
+	// Update fails with ErrTypeConflict if the placement changed since it was read.
+	Update(ctx context.Context, placement *ScheduledPlacement) *Error
+	ListDue(ctx context.Context, now time.Time) ([]*ScheduledPlacement, *Error)
+	ListStuck(ctx context.Context, startedBefore time.Time) ([]*ScheduledPlacement, *Error)
+	ListByOrg(ctx context.Context, org Org) ([]*ScheduledPlacement, *Error)
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduledPlacementState(t *testing.T) {
+	now := time.Unix(1000, 0)
+	tests := []struct {
+		name      string
+		placement ScheduledPlacement
+		due       bool
+		stuck     bool
+	}{
+		{
+			name:      "pending before its start",
+			placement: ScheduledPlacement{Status: ScheduledPlacementStatusPending, StartAt: 1001},
+		},
+		{
+			name:      "pending at its start",
+			placement: ScheduledPlacement{Status: ScheduledPlacementStatusPending, StartAt: 1000},
+			due:       true,
+		},
+		{
+			name:      "cancelled past its start",
+			placement: ScheduledPlacement{Status: ScheduledPlacementStatusCancelled, StartAt: 900},
+		},
+		{
+			name:      "started recently",
+			placement: ScheduledPlacement{Status: ScheduledPlacementStatusRunning, StartAt: 900, StartedAt: 1000},
+		},
+		{
+			name:      "started long ago",
+			placement: ScheduledPlacement{Status: ScheduledPlacementStatusRunning, StartAt: 900, StartedAt: 999},
+			stuck:     true,
+		},
+		{
+			name:      "completed long ago",
+			placement: ScheduledPlacement{Status: ScheduledPlacementStatusCompleted, StartAt: 900, StartedAt: 900},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.placement.Due(now); got != tt.due {
+				t.Errorf("Due() = %t, want %t", got, tt.due)
+			}
+			if got := tt.placement.Stuck(now); got != tt.stuck {
+				t.Errorf("Stuck() = %t, want %t", got, tt.stuck)
+			}
+		})
+	}
+}
+
+func TestScheduledPlacementCancel(t *testing.T) {
+	tests := []struct {
+		status   ScheduledPlacementStatus
+		conflict bool
+	}{
+		{status: ScheduledPlacementStatusPending},
+		{status: ScheduledPlacementStatusRunning, conflict: true},
+		{status: ScheduledPlacementStatusCompleted, conflict: true},
+		{status: ScheduledPlacementStatusCancelled, conflict: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			placement := ScheduledPlacement{Id: "s1", Status: tt.status}
+			err := placement.Cancel("by user")
+			if (err != nil) != tt.conflict || (err != nil && err.ErrType() != ErrTypeConflict) {
+				t.Fatalf("err = %v, want conflict = %t", err, tt.conflict)
+			}
+			if !tt.conflict && (placement.Status != ScheduledPlacementStatusCancelled || placement.Reason != "by user") {
+				t.Errorf("placement is %s (%s), want cancelled by user", placement.Status, placement.Reason)
+			}
+			if tt.conflict && placement.Status != tt.status {
+				t.Errorf("status changed to %s", placement.Status)
+			}
+		})
+	}
+}
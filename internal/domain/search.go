@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type ConfigFilter struct {
+	Namespaces      []string
+	NamePrefix      string
+	ParamKey        string
+	ParamValue      string
+	ParamValueRegex *regexp.Regexp
+	CreatedAfter    int64
+	CreatedBefore   int64
+	// every label has to be set on the config with the same value
+	Labels map[string]string
+}
+
+func (f ConfigFilter) MatchConfig(config Config) bool {
+	if len(f.Namespaces) > 0 && !slices.Contains(f.Namespaces, config.Namespace()) {
+		return false
+	}
+	if !strings.HasPrefix(config.Name(), f.NamePrefix) {
+		return false
+	}
+	if f.CreatedAfter > 0 && config.CreatedAtUnixSec() < f.CreatedAfter {
+		return false
+	}
+	if f.CreatedBefore > 0 && config.CreatedAtUnixSec() > f.CreatedBefore {
+		return false
+	}
+	for key, value := range f.Labels {
+		if label, ok := config.Labels()[key]; !ok || label != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (f ConfigFilter) MatchParamSets(paramSets []NamedParamSet) bool {
+	if !f.filtersParams() {
+		return true
+	}
+	return slices.ContainsFunc(paramSets, f.MatchParamSet)
+}
+
+func (f ConfigFilter) MatchParamSet(paramSet NamedParamSet) bool {
+	if !f.filtersParams() {
+		return true
+	}
+	for key, value := range paramSet.params {
+		if f.ParamKey != "" && key != f.ParamKey {
+			continue
+		}
+		if f.ParamValueRegex != nil && !f.ParamValueRegex.MatchString(value) {
+			continue
+		}
+		if f.ParamValueRegex == nil && f.ParamValue != "" && value != f.ParamValue {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func (f ConfigFilter) filtersParams() bool {
+	return f.ParamKey != "" || f.ParamValue != "" || f.ParamValueRegex != nil
+}
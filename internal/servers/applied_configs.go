@@ -0,0 +1,74 @@
+package servers
+
+import (
+	"context"
+	"log"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/internal/services"
+	"github.com/c12s/kuiper/pkg/api"
+	"google.golang.org/protobuf/proto"
+)
+
+type AppliedConfigReports struct {
+	drift *services.DriftService
+}
+
+func NewAppliedConfigReports(drift *services.DriftService) *AppliedConfigReports {
+	return &AppliedConfigReports{
+		drift: drift,
+	}
+}
+
+func (r *AppliedConfigReports) Handle(msg []byte, _ string) {
+	report := &api.AppliedConfigReport{}
+	err := proto.Unmarshal(msg, report)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if report.Organization == "" || report.NodeId == "" {
+		log.Println("applied config report without organization or node id")
+		return
+	}
+
+	applied := make([]domain.AppliedConfig, 0, len(report.Configs))
+	for _, config := range report.Configs {
+		if config.Config == nil || config.Config.Organization != report.Organization {
+			log.Printf("skipping applied config not owned by %s", report.Organization)
+			continue
+		}
+		configType, ok := mapAppliedConfigType(config.Type)
+		if !ok {
+			log.Printf("unknown applied config type %s", config.Type)
+			continue
+		}
+		applied = append(applied, domain.AppliedConfig{
+			Config: domain.ConfigRef{
+				Namespace: config.Config.Namespace,
+				Name:      config.Config.Name,
+				Version:   config.Config.Version,
+				Type:      configType,
+			},
+			ContentHash: config.ContentHash,
+			TaskId:      config.TaskId,
+		})
+	}
+
+	reportErr := r.drift.Report(context.Background(), domain.Org(report.Organization), domain.Node(report.NodeId), applied)
+	if reportErr != nil {
+		log.Println(reportErr)
+	}
+}
+
+// agents echo the type from ApplyConfigCommand
+func mapAppliedConfigType(configType string) (string, bool) {
+	switch configType {
+	case domain.ConfTypeStandalone:
+		return domain.ConfTypeStandalone, true
+	case "group", domain.ConfTypeGroup:
+		return domain.ConfTypeGroup, true
+	default:
+		return "", false
+	}
+}
@@ -2,6 +2,9 @@ package servers
 
 import (
 	"context"
+	"log"
+	"regexp"
+	"time"
 
 	"github.com/c12s/kuiper/internal/domain"
 	"github.com/c12s/kuiper/internal/services"
@@ -9,6 +12,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/c12s/kuiper/pkg/api"
 	quasarapi "github.com/c12s/quasar/proto"
@@ -16,20 +20,36 @@ import (
 
 type KuiperGrpcServer struct {
 	api.UnimplementedKuiperServer
-	standalone *services.StandaloneConfigService
-	groups     *services.ConfigGroupService
+	standalone  *services.StandaloneConfigService
+	groups      *services.ConfigGroupService
+	placements  *services.PlacementService
+	drift       *services.DriftService
+	diffs       *services.DiffService
+	rollouts    *services.RolloutService
+	maintenance *services.MaintenanceService
+	schedules   *services.ScheduleService
 }
 
-func NewKuiperServer(standalone *services.StandaloneConfigService, groups *services.ConfigGroupService) api.KuiperServer {
+func NewKuiperServer(standalone *services.StandaloneConfigService, groups *services.ConfigGroupService, placements *services.PlacementService, drift *services.DriftService, diffs *services.DiffService, rollouts *services.RolloutService, maintenance *services.MaintenanceService, schedules *services.ScheduleService) api.KuiperServer {
 	return &KuiperGrpcServer{
-		standalone: standalone,
-		groups:     groups,
+		standalone:  standalone,
+		groups:      groups,
+		placements:  placements,
+		drift:       drift,
+		diffs:       diffs,
+		rollouts:    rollouts,
+		maintenance: maintenance,
+		schedules:   schedules,
 	}
 }
 
 func (s *KuiperGrpcServer) PutStandaloneConfig(ctx context.Context, req *api.NewStandaloneConfig) (*api.StandaloneConfig, error) {
+	if err := validateNewStandaloneConfig(req); err != nil {
+		return nil, err
+	}
 	paramSet := mapProtoParamSet(req.Name, req.ParamSet)
 	config := domain.NewStandaloneConfig(domain.Org(req.Organization), req.Namespace, req.Version, *paramSet)
+	config.SetLabels(req.Labels)
 	var schema *quasarapi.ConfigSchemaDetails
 	if req.Schema != nil {
 		schema = &quasarapi.ConfigSchemaDetails{
@@ -43,33 +63,26 @@ func (s *KuiperGrpcServer) PutStandaloneConfig(ctx context.Context, req *api.New
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
-	resp := &api.StandaloneConfig{
-		Organization: string(config.Org()),
-		Name:         config.Name(),
-		Version:      config.Version(),
-		CreatedAt:    config.CreatedAtUTC().String(),
-		ParamSet:     mapParamSet(config.ParamSet()),
-	}
+	resp := mapStandaloneConfig(config)
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) GetStandaloneConfig(ctx context.Context, req *api.ConfigId) (*api.StandaloneConfig, error) {
+	if err := validateConfigId(req); err != nil {
+		return nil, err
+	}
 	config, err := s.standalone.Get(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
-	resp := &api.StandaloneConfig{
-		Organization: string(config.Org()),
-		Namespace:    config.Namespace(),
-		Name:         config.Name(),
-		Version:      config.Version(),
-		CreatedAt:    config.CreatedAtUTC().String(),
-		ParamSet:     mapParamSet(config.ParamSet()),
-	}
+	resp := mapStandaloneConfig(config)
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) ListStandaloneConfig(ctx context.Context, req *api.ListStandaloneConfigReq) (*api.ListStandaloneConfigResp, error) {
+	if err := validateListReq(req.Organization, req.Namespace); err != nil {
+		return nil, err
+	}
 	configs, err := s.standalone.List(ctx, domain.Org(req.Organization), req.Namespace)
 	if err := mapError(err); err != nil {
 		return nil, err
@@ -78,42 +91,69 @@ func (s *KuiperGrpcServer) ListStandaloneConfig(ctx context.Context, req *api.Li
 		Configurations: make([]*api.StandaloneConfig, 0),
 	}
 	for _, config := range configs {
-		configProto := &api.StandaloneConfig{
-			Organization: string(config.Org()),
-			Namespace:    config.Namespace(),
-			Name:         config.Name(),
-			Version:      config.Version(),
-			CreatedAt:    config.CreatedAtUTC().String(),
-			ParamSet:     mapParamSet(config.ParamSet()),
-		}
+		configProto := mapStandaloneConfig(config)
 		resp.Configurations = append(resp.Configurations, configProto)
 	}
 	return resp, nil
 }
 
+func (s *KuiperGrpcServer) ListOrgStandaloneConfig(ctx context.Context, req *api.ListOrgConfigReq) (*api.ListStandaloneConfigResp, error) {
+	return s.SearchStandaloneConfig(ctx, &api.SearchConfigReq{
+		Organization: req.Organization,
+		PageSize:     req.PageSize,
+		PageToken:    req.PageToken,
+	})
+}
+
+func (s *KuiperGrpcServer) SearchStandaloneConfig(ctx context.Context, req *api.SearchConfigReq) (*api.ListStandaloneConfigResp, error) {
+	if err := validateSearchReq(req); err != nil {
+		return nil, err
+	}
+	filter, err := mapSearchFilter(req)
+	if err != nil {
+		return nil, err
+	}
+	configs, nextPageToken, searchErr := s.standalone.Search(ctx, domain.Org(req.Organization), filter, req.PageSize, req.PageToken)
+	if err := mapError(searchErr); err != nil {
+		return nil, err
+	}
+	resp := &api.ListStandaloneConfigResp{
+		Configurations: make([]*api.StandaloneConfig, 0),
+		NextPageToken:  nextPageToken,
+	}
+	for _, config := range configs {
+		resp.Configurations = append(resp.Configurations, mapStandaloneConfig(config))
+	}
+	return resp, nil
+}
+
 func (s *KuiperGrpcServer) DeleteStandaloneConfig(ctx context.Context, req *api.ConfigId) (*api.StandaloneConfig, error) {
+	if err := validateConfigId(req); err != nil {
+		return nil, err
+	}
 	config, err := s.standalone.Delete(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
-	resp := &api.StandaloneConfig{
-		Organization: string(config.Org()),
-		Namespace:    config.Namespace(),
-		Name:         config.Name(),
-		Version:      config.Version(),
-		CreatedAt:    config.CreatedAtUTC().String(),
-		ParamSet:     mapParamSet(config.ParamSet()),
-	}
+	resp := mapStandaloneConfig(config)
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) DiffStandaloneConfig(ctx context.Context, req *api.DiffReq) (*api.DiffStandaloneConfigResp, error) {
-	diffs, err := s.standalone.Diff(ctx, domain.Org(req.Reference.Organization), req.Reference.Namespace, req.Reference.Name, req.Reference.Version, domain.Org(req.Diff.Organization), req.Diff.Namespace, req.Diff.Name, req.Diff.Version)
+	if err := validateDiffReq(req); err != nil {
+		return nil, err
+	}
+	format, formatErr := mapDiffFormat(req.Format)
+	if formatErr != nil {
+		return nil, formatErr
+	}
+	diffs, patch, err := s.standalone.Diff(ctx, domain.Org(req.Reference.Organization), req.Reference.Namespace, req.Reference.Name, req.Reference.Version, domain.Org(req.Diff.Organization), req.Diff.Namespace, req.Diff.Name, req.Diff.Version, format)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
 	resp := &api.DiffStandaloneConfigResp{
 		Diffs: make([]*api.Diff, 0),
+		Patch: patch,
 	}
 	for _, diff := range diffs {
 		resp.Diffs = append(resp.Diffs, &api.Diff{Type: string(diff.Type()), Diff: diff.Diff()})
@@ -121,20 +161,70 @@ func (s *KuiperGrpcServer) DiffStandaloneConfig(ctx context.Context, req *api.Di
 	return resp, nil
 }
 
+func (s *KuiperGrpcServer) MergeStandaloneConfig(ctx context.Context, req *api.MergeReq) (*api.MergeStandaloneConfigResp, error) {
+	if err := validateMergeReq(req); err != nil {
+		return nil, err
+	}
+	schema := mapSchema(req.Ours.Organization, req.Ours.Namespace, req.Schema)
+	merged, conflicts, saved, err := s.standalone.Merge(ctx, mapConfigRef(req.Base), mapConfigRef(req.Ours), mapConfigRef(req.Theirs), req.Save, req.Version, schema)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.MergeStandaloneConfigResp{
+		Merged:    mapStandaloneConfig(merged),
+		Conflicts: mapMergeConflicts(conflicts),
+		Saved:     saved,
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) PatchStandaloneConfig(ctx context.Context, req *api.PatchReq) (*api.StandaloneConfig, error) {
+	if err := validatePatchReq(req, false); err != nil {
+		return nil, err
+	}
+	schema := mapSchema(req.Source.Organization, req.Source.Namespace, req.Schema)
+	config, err := s.standalone.Patch(ctx, domain.Org(req.Source.Organization), req.Source.Namespace, req.Source.Name, req.Source.Version, req.Version, mapPatchOps(req.Operations), []byte(req.JsonPatch), req.ExpectedHash, schema)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapStandaloneConfig(config), nil
+}
+
 func (s *KuiperGrpcServer) PlaceStandaloneConfig(ctx context.Context, req *api.PlaceReq) (*api.PlaceResp, error) {
-	tasks, err := s.standalone.Place(ctx, domain.Org(req.Config.Organization), req.Config.Namespace, req.Config.Name, req.Config.Version, req.Strategy)
+	if err := validatePlaceReq(req); err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		return s.planPlacement(ctx, req, domain.ConfTypeStandalone)
+	}
+	if req.Rollout != nil || req.Rolling != nil {
+		return s.startRollout(ctx, req, domain.ConfTypeStandalone)
+	}
+	placementId, tasks, violations, err := s.standalone.Place(ctx, domain.Org(req.Config.Organization), req.Config.Namespace, req.Config.Name, req.Config.Version, req.Strategy, time.Duration(req.TimeoutSeconds)*time.Second, req.Async)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
+	if err := s.persist(ctx, req, domain.ConfTypeStandalone); err != nil {
+		return nil, err
+	}
 	resp := &api.PlaceResp{
-		Tasks: mapTasks(tasks),
+		Tasks:       mapTasks(tasks),
+		Violations:  mapViolations(violations),
+		PlacementId: placementId,
 	}
 	return resp, nil
 }
 
-func (s *KuiperGrpcServer) ListPlacementTaskByStandaloneConfig(ctx context.Context, req *api.ConfigId) (*api.ListPlacementTaskResp, error) {
-	tasks, err := s.standalone.ListPlacementTasks(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version)
-	if err := mapError(err); err != nil {
+func (s *KuiperGrpcServer) ListPlacementTaskByStandaloneConfig(ctx context.Context, req *api.ListPlacementTaskReq) (*api.ListPlacementTaskResp, error) {
+	if err := validateListPlacementTaskReq(req); err != nil {
+		return nil, err
+	}
+	statuses, err := mapProtoTaskStatuses(req.Statuses)
+	if err != nil {
+		return nil, err
+	}
+	tasks, listErr := s.standalone.ListPlacementTasks(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version, req.PlacementId, statuses)
+	if err := mapError(listErr); err != nil {
 		return nil, err
 	}
 	resp := &api.ListPlacementTaskResp{
@@ -143,9 +233,25 @@ func (s *KuiperGrpcServer) ListPlacementTaskByStandaloneConfig(ctx context.Conte
 	return resp, nil
 }
 
+func (s *KuiperGrpcServer) WatchStandaloneConfigPlacement(req *api.WatchPlacementReq, stream api.Kuiper_WatchStandaloneConfigPlacementServer) error {
+	return s.watchPlacement(stream.Context(), req, domain.ConfTypeStandalone, stream.Send)
+}
+
+func (s *KuiperGrpcServer) CancelStandaloneConfigPlacement(ctx context.Context, req *api.CancelPlacementReq) (*api.CancelPlacementResp, error) {
+	return s.cancelPlacement(ctx, req, domain.ConfTypeStandalone)
+}
+
+func (s *KuiperGrpcServer) RollbackStandaloneConfig(ctx context.Context, req *api.ConfigId) (*api.RollbackResp, error) {
+	return s.rollback(ctx, req, domain.ConfTypeStandalone)
+}
+
 func (s *KuiperGrpcServer) PutConfigGroup(ctx context.Context, req *api.NewConfigGroup) (*api.ConfigGroup, error) {
+	if err := validateNewConfigGroup(req); err != nil {
+		return nil, err
+	}
 	paramSets := mapProtoParamSets(req.ParamSets)
 	config := domain.NewConfigGroup(domain.Org(req.Organization), req.Namespace, req.Name, req.Version, paramSets)
+	config.SetLabels(req.Labels)
 	var schema *quasarapi.ConfigSchemaDetails
 	if req.Schema != nil {
 		schema = &quasarapi.ConfigSchemaDetails{
@@ -161,34 +267,26 @@ func (s *KuiperGrpcServer) PutConfigGroup(ctx context.Context, req *api.NewConfi
 		return nil, err
 	}
 
-	resp := &api.ConfigGroup{
-		Organization: string(config.Org()),
-		Namespace:    config.Namespace(),
-		Name:         config.Name(),
-		Version:      config.Version(),
-		CreatedAt:    config.CreatedAtUTC().String(),
-		ParamSets:    mapParamSets(config.ParamSets()),
-	}
+	resp := mapConfigGroup(config)
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) GetConfigGroup(ctx context.Context, req *api.ConfigId) (*api.ConfigGroup, error) {
+	if err := validateConfigId(req); err != nil {
+		return nil, err
+	}
 	config, err := s.groups.Get(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
-	resp := &api.ConfigGroup{
-		Organization: string(config.Org()),
-		Namespace:    config.Namespace(),
-		Name:         config.Name(),
-		Version:      config.Version(),
-		CreatedAt:    config.CreatedAtUTC().String(),
-		ParamSets:    mapParamSets(config.ParamSets()),
-	}
+	resp := mapConfigGroup(config)
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) ListConfigGroup(ctx context.Context, req *api.ListConfigGroupReq) (*api.ListConfigGroupResp, error) {
+	if err := validateListReq(req.Organization, req.Namespace); err != nil {
+		return nil, err
+	}
 	configs, err := s.groups.List(ctx, domain.Org(req.Organization), req.Namespace)
 	if err := mapError(err); err != nil {
 		return nil, err
@@ -197,43 +295,72 @@ func (s *KuiperGrpcServer) ListConfigGroup(ctx context.Context, req *api.ListCon
 		Groups: make([]*api.ConfigGroup, 0),
 	}
 	for _, config := range configs {
-		configProto := &api.ConfigGroup{
-			Organization: string(config.Org()),
-			Namespace:    config.Namespace(),
-			Name:         config.Name(),
-			Version:      config.Version(),
-			CreatedAt:    config.CreatedAtUTC().String(),
-			ParamSets:    mapParamSets(config.ParamSets()),
-		}
+		configProto := mapConfigGroup(config)
 		resp.Groups = append(resp.Groups, configProto)
 	}
 	return resp, nil
 }
 
+func (s *KuiperGrpcServer) ListOrgConfigGroup(ctx context.Context, req *api.ListOrgConfigReq) (*api.ListConfigGroupResp, error) {
+	return s.SearchConfigGroup(ctx, &api.SearchConfigReq{
+		Organization: req.Organization,
+		PageSize:     req.PageSize,
+		PageToken:    req.PageToken,
+	})
+}
+
+func (s *KuiperGrpcServer) SearchConfigGroup(ctx context.Context, req *api.SearchConfigReq) (*api.ListConfigGroupResp, error) {
+	if err := validateSearchReq(req); err != nil {
+		return nil, err
+	}
+	filter, err := mapSearchFilter(req)
+	if err != nil {
+		return nil, err
+	}
+	configs, nextPageToken, searchErr := s.groups.Search(ctx, domain.Org(req.Organization), filter, req.PageSize, req.PageToken)
+	if err := mapError(searchErr); err != nil {
+		return nil, err
+	}
+	resp := &api.ListConfigGroupResp{
+		Groups:        make([]*api.ConfigGroup, 0),
+		NextPageToken: nextPageToken,
+	}
+	for _, config := range configs {
+		resp.Groups = append(resp.Groups, mapConfigGroup(config))
+	}
+	return resp, nil
+}
+
 func (s *KuiperGrpcServer) DeleteConfigGroup(ctx context.Context, req *api.ConfigId) (*api.ConfigGroup, error) {
+	if err := validateConfigId(req); err != nil {
+		return nil, err
+	}
 	config, err := s.groups.Delete(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
-	resp := &api.ConfigGroup{
-		Organization: string(config.Org()),
-		Namespace:    config.Namespace(),
-		Name:         config.Name(),
-		Version:      config.Version(),
-		CreatedAt:    config.CreatedAtUTC().String(),
-		ParamSets:    mapParamSets(config.ParamSets()),
-	}
+	resp := mapConfigGroup(config)
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) DiffConfigGroup(ctx context.Context, req *api.DiffReq) (*api.DiffConfigGroupResp, error) {
-	diffsByConfig, err := s.groups.Diff(ctx, domain.Org(req.Reference.Organization), req.Reference.Namespace, req.Reference.Name, req.Reference.Version, domain.Org(req.Diff.Organization), req.Diff.Namespace, req.Diff.Name, req.Diff.Version)
+	if err := validateDiffReq(req); err != nil {
+		return nil, err
+	}
+	format, formatErr := mapDiffFormat(req.Format)
+	if formatErr != nil {
+		return nil, formatErr
+	}
+	diffsByConfig, patch, err := s.groups.Diff(ctx, domain.Org(req.Reference.Organization), req.Reference.Namespace, req.Reference.Name, req.Reference.Version, domain.Org(req.Diff.Organization), req.Diff.Namespace, req.Diff.Name, req.Diff.Version, format)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
 	resp := &api.DiffConfigGroupResp{
 		Diffs: make(map[string]*api.Diffs),
+		Patch: patch,
+		Stats: make(map[string]*api.DiffStats),
 	}
+	total := domain.DiffStats{}
 	for config, diffs := range diffsByConfig {
 		diffsProto := &api.Diffs{
 			Diffs: make([]*api.Diff, 0),
@@ -242,24 +369,78 @@ func (s *KuiperGrpcServer) DiffConfigGroup(ctx context.Context, req *api.DiffReq
 			diffsProto.Diffs = append(diffsProto.Diffs, &api.Diff{Type: string(diff.Type()), Diff: diff.Diff()})
 		}
 		resp.Diffs[config] = diffsProto
+		stats := domain.CountDiffs(diffs)
+		resp.Stats[config] = mapDiffStats(stats)
+		total = total.Add(stats)
 	}
+	resp.Total = mapDiffStats(total)
 	return resp, nil
 }
 
+func (s *KuiperGrpcServer) MergeConfigGroup(ctx context.Context, req *api.MergeReq) (*api.MergeConfigGroupResp, error) {
+	if err := validateMergeReq(req); err != nil {
+		return nil, err
+	}
+	schema := mapSchema(req.Ours.Organization, req.Ours.Namespace, req.Schema)
+	merged, conflicts, saved, err := s.groups.Merge(ctx, mapConfigRef(req.Base), mapConfigRef(req.Ours), mapConfigRef(req.Theirs), req.Save, req.Version, schema)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.MergeConfigGroupResp{
+		Merged:    mapConfigGroup(merged),
+		Conflicts: mapMergeConflicts(conflicts),
+		Saved:     saved,
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) PatchConfigGroup(ctx context.Context, req *api.PatchReq) (*api.ConfigGroup, error) {
+	if err := validatePatchReq(req, true); err != nil {
+		return nil, err
+	}
+	schema := mapSchema(req.Source.Organization, req.Source.Namespace, req.Schema)
+	config, err := s.groups.Patch(ctx, domain.Org(req.Source.Organization), req.Source.Namespace, req.Source.Name, req.Source.Version, req.Version, mapPatchOps(req.Operations), []byte(req.JsonPatch), req.ExpectedHash, schema)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapConfigGroup(config), nil
+}
+
 func (s *KuiperGrpcServer) PlaceConfigGroup(ctx context.Context, req *api.PlaceReq) (*api.PlaceResp, error) {
-	tasks, err := s.groups.Place(ctx, domain.Org(req.Config.Organization), req.Config.Namespace, req.Config.Name, req.Config.Version, req.Strategy)
+	if err := validatePlaceReq(req); err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		return s.planPlacement(ctx, req, domain.ConfTypeGroup)
+	}
+	if req.Rollout != nil || req.Rolling != nil {
+		return s.startRollout(ctx, req, domain.ConfTypeGroup)
+	}
+	placementId, tasks, violations, err := s.groups.Place(ctx, domain.Org(req.Config.Organization), req.Config.Namespace, req.Config.Name, req.Config.Version, req.Strategy, time.Duration(req.TimeoutSeconds)*time.Second, req.Async)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
+	if err := s.persist(ctx, req, domain.ConfTypeGroup); err != nil {
+		return nil, err
+	}
 	resp := &api.PlaceResp{
-		Tasks: mapTasks(tasks),
+		Tasks:       mapTasks(tasks),
+		Violations:  mapViolations(violations),
+		PlacementId: placementId,
 	}
 	return resp, nil
 }
 
-func (s *KuiperGrpcServer) ListPlacementTaskByConfigGroup(ctx context.Context, req *api.ConfigId) (*api.ListPlacementTaskResp, error) {
-	tasks, err := s.groups.ListPlacementTasks(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version)
-	if err := mapError(err); err != nil {
+func (s *KuiperGrpcServer) ListPlacementTaskByConfigGroup(ctx context.Context, req *api.ListPlacementTaskReq) (*api.ListPlacementTaskResp, error) {
+	if err := validateListPlacementTaskReq(req); err != nil {
+		return nil, err
+	}
+	statuses, err := mapProtoTaskStatuses(req.Statuses)
+	if err != nil {
+		return nil, err
+	}
+	tasks, listErr := s.groups.ListPlacementTasks(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version, req.PlacementId, statuses)
+	if err := mapError(listErr); err != nil {
 		return nil, err
 	}
 	resp := &api.ListPlacementTaskResp{
@@ -268,14 +449,470 @@ func (s *KuiperGrpcServer) ListPlacementTaskByConfigGroup(ctx context.Context, r
 	return resp, nil
 }
 
+func (s *KuiperGrpcServer) WatchConfigGroupPlacement(req *api.WatchPlacementReq, stream api.Kuiper_WatchConfigGroupPlacementServer) error {
+	return s.watchPlacement(stream.Context(), req, domain.ConfTypeGroup, stream.Send)
+}
+
+func (s *KuiperGrpcServer) watchPlacement(ctx context.Context, req *api.WatchPlacementReq, configType string, send func(event *api.PlacementEvent) error) error {
+	if err := validateWatchPlacementReq(req); err != nil {
+		return err
+	}
+	ref := domain.ConfigRef{
+		Org:       domain.Org(req.Organization),
+		Namespace: req.Namespace,
+		Name:      req.Name,
+		Version:   req.Version,
+		Type:      configType,
+	}
+	summary, err := s.placements.Watch(ctx, ref, req.PlacementId, req.TaskIds, time.Duration(req.TimeoutSeconds)*time.Second, func(task domain.PlacementTask) error {
+		return send(&api.PlacementEvent{Event: &api.PlacementEvent_Task{Task: mapTask(task)}})
+	})
+	if err := mapError(err); err != nil {
+		return err
+	}
+	return send(&api.PlacementEvent{Event: &api.PlacementEvent_Summary{Summary: mapPlacementSummary(summary)}})
+}
+
+func (s *KuiperGrpcServer) CancelConfigGroupPlacement(ctx context.Context, req *api.CancelPlacementReq) (*api.CancelPlacementResp, error) {
+	return s.cancelPlacement(ctx, req, domain.ConfTypeGroup)
+}
+
+func (s *KuiperGrpcServer) cancelPlacement(ctx context.Context, req *api.CancelPlacementReq, configType string) (*api.CancelPlacementResp, error) {
+	if err := validateCancelPlacementReq(req); err != nil {
+		return nil, err
+	}
+	ref := domain.ConfigRef{
+		Org:       domain.Org(req.Organization),
+		Namespace: req.Namespace,
+		Name:      req.Name,
+		Version:   req.Version,
+		Type:      configType,
+	}
+	cancelled, err := s.placements.Cancel(ctx, ref, req.PlacementId, req.TaskIds)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return &api.CancelPlacementResp{
+		Cancelled:          mapTasks(cancelled.Tasks),
+		AbortedRollouts:    cancelled.AbortedRollouts,
+		CancelledSchedules: cancelled.CancelledSchedules,
+		PersistentDeleted:  cancelled.PersistentDeleted,
+	}, nil
+}
+
+func (s *KuiperGrpcServer) RollbackConfigGroup(ctx context.Context, req *api.ConfigId) (*api.RollbackResp, error) {
+	return s.rollback(ctx, req, domain.ConfTypeGroup)
+}
+
+func (s *KuiperGrpcServer) rollback(ctx context.Context, req *api.ConfigId, configType string) (*api.RollbackResp, error) {
+	if err := validateConfigId(req); err != nil {
+		return nil, err
+	}
+	ref := domain.ConfigRef{
+		Org:       domain.Org(req.Organization),
+		Namespace: req.Namespace,
+		Name:      req.Name,
+		Version:   req.Version,
+		Type:      configType,
+	}
+	rollbacks, skipped, err := s.placements.Rollback(ctx, ref)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.RollbackResp{
+		Rollbacks:    make([]*api.RollbackPlacement, 0, len(rollbacks)),
+		SkippedNodes: make([]string, 0, len(skipped)),
+	}
+	for _, rollback := range rollbacks {
+		resp.Rollbacks = append(resp.Rollbacks, &api.RollbackPlacement{
+			Superseded: mapNodePlacement(rollback.Superseded),
+			Restored:   mapNodePlacement(rollback.Restored),
+		})
+	}
+	for _, node := range skipped {
+		resp.SkippedNodes = append(resp.SkippedNodes, string(node))
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) ListPlacementsByNode(ctx context.Context, req *api.ListPlacementsByNodeReq) (*api.ListPlacementsByNodeResp, error) {
+	if err := validateListPlacementsByNodeReq(req); err != nil {
+		return nil, err
+	}
+	statuses, err := mapProtoTaskStatuses(req.Statuses)
+	if err != nil {
+		return nil, err
+	}
+	placements, nextPageToken, listErr := s.placements.ListByNode(ctx, domain.Org(req.Organization), domain.Node(req.Node), statuses, req.PageSize, req.PageToken)
+	if err := mapError(listErr); err != nil {
+		return nil, err
+	}
+	resp := &api.ListPlacementsByNodeResp{
+		Placements:    make([]*api.NodePlacement, 0),
+		NextPageToken: nextPageToken,
+	}
+	for _, placement := range placements {
+		resp.Placements = append(resp.Placements, mapNodePlacement(placement))
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) ListDrift(ctx context.Context, req *api.ListDriftReq) (*api.ListDriftResp, error) {
+	if err := validateListDriftReq(req); err != nil {
+		return nil, err
+	}
+	drifts, err := s.drift.List(ctx, domain.Org(req.Organization), req.Namespace, domain.Node(req.Node))
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.ListDriftResp{
+		Drifts: make([]*api.ConfigDrift, 0, len(drifts)),
+	}
+	for _, drift := range drifts {
+		resp.Drifts = append(resp.Drifts, mapConfigDrift(drift))
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) DiffParamSets(ctx context.Context, req *api.DiffParamSetsReq) (*api.DiffParamSetsResp, error) {
+	if err := validateDiffParamSetsReq(req); err != nil {
+		return nil, err
+	}
+	format, formatErr := mapDiffFormat(req.Format)
+	if formatErr != nil {
+		return nil, formatErr
+	}
+	diffs, patch, err := s.diffs.Diff(ctx, mapDiffSide(req.Reference), mapDiffSide(req.Diff), format)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.DiffParamSetsResp{
+		Diffs: make([]*api.Diff, 0, len(diffs)),
+		Patch: patch,
+		Stats: mapDiffStats(domain.CountDiffs(diffs)),
+	}
+	for _, diff := range diffs {
+		resp.Diffs = append(resp.Diffs, mapDiff(diff))
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) ListPlacementStrategies(ctx context.Context, req *api.ListPlacementStrategiesReq) (*api.ListPlacementStrategiesResp, error) {
+	resp := &api.ListPlacementStrategiesResp{
+		Strategies: make([]*api.PlacementStrategy, 0),
+	}
+	for _, strategy := range s.placements.ListStrategies() {
+		protoStrategy := &api.PlacementStrategy{
+			Name:        strategy.Name(),
+			Description: strategy.Description(),
+			Params:      make([]*api.PlacementStrategyParam, 0),
+		}
+		for _, param := range strategy.Params() {
+			protoStrategy.Params = append(protoStrategy.Params, &api.PlacementStrategyParam{
+				Name:        param.Name,
+				Type:        param.Type,
+				Description: param.Description,
+				Required:    param.Required,
+			})
+		}
+		resp.Strategies = append(resp.Strategies, protoStrategy)
+	}
+	return resp, nil
+}
+
+// persist stores the placement as the config's desired state if requested.
+// It runs after Place, so that a placement the client was told failed isn't
+// made later on by the reconciler.
+func (s *KuiperGrpcServer) persist(ctx context.Context, req *api.PlaceReq, configType string) error {
+	if !req.Persistent {
+		return nil
+	}
+	ref := mapConfigRef(req.Config)
+	ref.Type = configType
+	return mapError(s.placements.Persist(ctx, ref, req.Strategy, time.Duration(req.TimeoutSeconds)*time.Second, req.RemoveUnmatched))
+}
+
+func (s *KuiperGrpcServer) planPlacement(ctx context.Context, req *api.PlaceReq, configType string) (*api.PlaceResp, error) {
+	ref := mapConfigRef(req.Config)
+	ref.Type = configType
+	plans, violations, err := s.placements.Plan(ctx, ref, req.Strategy)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.PlaceResp{
+		Tasks:      make([]*api.PlacementTask, 0),
+		Plan:       make([]*api.PlacementPlan, 0, len(plans)),
+		Violations: mapViolations(violations),
+	}
+	for _, plan := range plans {
+		protoPlan := &api.PlacementPlan{
+			Node:   string(plan.Node),
+			Labels: plan.Labels,
+			Diffs:  make(map[string]*api.Diffs, len(plan.Diffs)),
+		}
+		if plan.Current != nil {
+			protoPlan.Current = mapConfigId(*plan.Current)
+		}
+		for paramSet, diffs := range plan.Diffs {
+			protoPlan.Diffs[paramSet] = mapDiffs(diffs)
+		}
+		resp.Plan = append(resp.Plan, protoPlan)
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) startRollout(ctx context.Context, req *api.PlaceReq, configType string) (*api.PlaceResp, error) {
+	ref := mapConfigRef(req.Config)
+	ref.Type = configType
+	rollout, violations, err := s.rollouts.Start(ctx, ref, req.Strategy, services.NewRolloutSpec(req))
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	tasks := make([]domain.PlacementTask, 0, len(rollout.Tasks))
+	for _, task := range rollout.Tasks {
+		tasks = append(tasks, *domain.NewPlacementTask(task.TaskId, task.Node, task.Status, rollout.CreatedAt, rollout.CreatedAt))
+	}
+	return &api.PlaceResp{
+		Tasks:      mapTasks(tasks),
+		RolloutId:  rollout.Id,
+		Violations: mapViolations(violations),
+	}, nil
+}
+
+func (s *KuiperGrpcServer) GetRollout(ctx context.Context, req *api.RolloutId) (*api.Rollout, error) {
+	if err := validateRolloutId(req.Organization, req.Id); err != nil {
+		return nil, err
+	}
+	rollout, err := s.rollouts.Get(ctx, domain.Org(req.Organization), req.Id)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapRollout(rollout), nil
+}
+
+func (s *KuiperGrpcServer) ListRollouts(ctx context.Context, req *api.ListRolloutsReq) (*api.ListRolloutsResp, error) {
+	if err := validateListRolloutsReq(req); err != nil {
+		return nil, err
+	}
+	rollouts, err := s.rollouts.List(ctx, domain.Org(req.Organization), req.Namespace, req.ActiveOnly)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.ListRolloutsResp{
+		Rollouts: make([]*api.Rollout, 0, len(rollouts)),
+	}
+	for _, rollout := range rollouts {
+		resp.Rollouts = append(resp.Rollouts, mapRollout(rollout))
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) PauseRollout(ctx context.Context, req *api.RolloutId) (*api.Rollout, error) {
+	if err := validateRolloutId(req.Organization, req.Id); err != nil {
+		return nil, err
+	}
+	rollout, err := s.rollouts.Pause(ctx, domain.Org(req.Organization), req.Id)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapRollout(rollout), nil
+}
+
+func (s *KuiperGrpcServer) ResumeRollout(ctx context.Context, req *api.RolloutId) (*api.Rollout, error) {
+	if err := validateRolloutId(req.Organization, req.Id); err != nil {
+		return nil, err
+	}
+	rollout, err := s.rollouts.Resume(ctx, domain.Org(req.Organization), req.Id)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapRollout(rollout), nil
+}
+
+func (s *KuiperGrpcServer) PromoteRollout(ctx context.Context, req *api.PromoteRolloutReq) (*api.Rollout, error) {
+	if err := validateRolloutId(req.Organization, req.Id); err != nil {
+		return nil, err
+	}
+	rollout, err := s.rollouts.Promote(ctx, domain.Org(req.Organization), req.Id, req.Full)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapRollout(rollout), nil
+}
+
+func (s *KuiperGrpcServer) AbortRollout(ctx context.Context, req *api.RolloutId) (*api.Rollout, error) {
+	if err := validateRolloutId(req.Organization, req.Id); err != nil {
+		return nil, err
+	}
+	rollout, err := s.rollouts.Abort(ctx, domain.Org(req.Organization), req.Id)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapRollout(rollout), nil
+}
+
+func (s *KuiperGrpcServer) PutMaintenanceWindow(ctx context.Context, req *api.MaintenanceWindow) (*api.MaintenanceWindow, error) {
+	if err := validateMaintenanceWindow(req); err != nil {
+		return nil, err
+	}
+	window, err := s.maintenance.Put(ctx, domain.MaintenanceWindow{
+		Org:       domain.Org(req.Organization),
+		Namespace: req.Namespace,
+		Name:      req.Name,
+		Schedule:  req.Schedule,
+		Duration:  time.Duration(req.DurationSeconds) * time.Second,
+	})
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapMaintenanceWindow(*window), nil
+}
+
+func (s *KuiperGrpcServer) DeleteMaintenanceWindow(ctx context.Context, req *api.MaintenanceWindowId) (*api.MaintenanceWindow, error) {
+	if err := validateDeleteMaintenanceWindowReq(req); err != nil {
+		return nil, err
+	}
+	window, err := s.maintenance.Delete(ctx, domain.Org(req.Organization), req.Namespace, req.Name)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapMaintenanceWindow(*window), nil
+}
+
+func (s *KuiperGrpcServer) ListMaintenanceWindows(ctx context.Context, req *api.ListMaintenanceWindowsReq) (*api.ListMaintenanceWindowsResp, error) {
+	if err := validateListMaintenanceWindowsReq(req); err != nil {
+		return nil, err
+	}
+	windows, err := s.maintenance.List(ctx, domain.Org(req.Organization), req.Namespace)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.ListMaintenanceWindowsResp{
+		Windows: make([]*api.MaintenanceWindow, 0, len(windows)),
+	}
+	for _, window := range windows {
+		resp.Windows = append(resp.Windows, mapMaintenanceWindow(window))
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) ScheduleStandaloneConfigPlacement(ctx context.Context, req *api.SchedulePlacementReq) (*api.ScheduledPlacement, error) {
+	return s.schedulePlacement(ctx, req, domain.ConfTypeStandalone)
+}
+
+func (s *KuiperGrpcServer) ScheduleConfigGroupPlacement(ctx context.Context, req *api.SchedulePlacementReq) (*api.ScheduledPlacement, error) {
+	return s.schedulePlacement(ctx, req, domain.ConfTypeGroup)
+}
+
+func (s *KuiperGrpcServer) schedulePlacement(ctx context.Context, req *api.SchedulePlacementReq, configType string) (*api.ScheduledPlacement, error) {
+	if err := validateSchedulePlacementReq(req); err != nil {
+		return nil, err
+	}
+	ref := mapConfigRef(req.Placement.Config)
+	ref.Type = configType
+	placement, err := s.schedules.Schedule(ctx, ref, req.Placement, time.Unix(req.StartAt, 0))
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapScheduledPlacement(placement), nil
+}
+
+func (s *KuiperGrpcServer) ListScheduledPlacements(ctx context.Context, req *api.ListScheduledPlacementsReq) (*api.ListScheduledPlacementsResp, error) {
+	if err := validateListScheduledPlacementsReq(req); err != nil {
+		return nil, err
+	}
+	placements, err := s.schedules.List(ctx, domain.Org(req.Organization), req.Namespace)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.ListScheduledPlacementsResp{
+		Placements: make([]*api.ScheduledPlacement, 0, len(placements)),
+	}
+	for _, placement := range placements {
+		resp.Placements = append(resp.Placements, mapScheduledPlacement(placement))
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) CancelScheduledPlacement(ctx context.Context, req *api.ScheduledPlacementId) (*api.ScheduledPlacement, error) {
+	if err := validateScheduledPlacementId(req); err != nil {
+		return nil, err
+	}
+	placement, err := s.schedules.Cancel(ctx, domain.Org(req.Organization), req.Id)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapScheduledPlacement(placement), nil
+}
+
+func (s *KuiperGrpcServer) ListPersistentPlacements(ctx context.Context, req *api.ListPersistentPlacementsReq) (*api.ListPersistentPlacementsResp, error) {
+	if err := validateListPersistentPlacementsReq(req); err != nil {
+		return nil, err
+	}
+	placements, err := s.placements.ListPersistent(ctx, domain.Org(req.Organization), req.Namespace)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.ListPersistentPlacementsResp{
+		Placements: make([]*api.PersistentPlacement, 0, len(placements)),
+	}
+	for _, placement := range placements {
+		resp.Placements = append(resp.Placements, mapPersistentPlacement(placement))
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) DeletePersistentPlacement(ctx context.Context, req *api.PersistentPlacementId) (*api.PersistentPlacement, error) {
+	if err := validatePersistentPlacementId(req); err != nil {
+		return nil, err
+	}
+	placement, err := s.placements.DeletePersistent(ctx, domain.ConfigRef{
+		Org:       domain.Org(req.Organization),
+		Namespace: req.Namespace,
+		Name:      req.Name,
+		Type:      req.Type,
+	})
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapPersistentPlacement(*placement), nil
+}
+
 func GetAuthInterceptor() func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if ok && len(md.Get("authz-token")) > 0 {
-			ctx = context.WithValue(ctx, "authz-token", md.Get("authz-token")[0])
-		}
-		return handler(ctx, req)
+		return handler(withRequestMetadata(ctx), req)
+	}
+}
+
+func GetStreamAuthInterceptor() func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &metadataServerStream{
+			ServerStream: stream,
+			ctx:          withRequestMetadata(stream.Context()),
+		})
+	}
+}
+
+// metadataServerStream replaces the context of the stream so that handlers
+// see the values taken from the request metadata.
+type metadataServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *metadataServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func withRequestMetadata(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok && len(md.Get("authz-token")) > 0 {
+		ctx = context.WithValue(ctx, "authz-token", md.Get("authz-token")[0])
+	}
+	if ok && len(md.Get("linearizable-read")) > 0 && md.Get("linearizable-read")[0] == "true" {
+		ctx = domain.WithLinearizableRead(ctx)
 	}
+	return ctx
 }
 
 func mapError(err *domain.Error) error {
@@ -297,11 +934,309 @@ func mapError(err *domain.Error) error {
 		return status.Error(codes.Internal, err.Message())
 	case domain.ErrTypeSchemaInvalid:
 		return status.Error(codes.InvalidArgument, err.Message())
+	case domain.ErrTypeConflict:
+		return status.Error(codes.Aborted, err.Message())
+	case domain.ErrTypeFailedPrecondition:
+		return status.Error(codes.FailedPrecondition, err.Message())
 	default:
 		return status.Error(codes.Unknown, err.Message())
 	}
 }
 
+func mapSearchFilter(req *api.SearchConfigReq) (domain.ConfigFilter, error) {
+	filter := domain.ConfigFilter{
+		Namespaces:    req.Namespaces,
+		NamePrefix:    req.NamePrefix,
+		ParamKey:      req.ParamKey,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+		Labels:        req.Labels,
+	}
+	if !req.ParamValueRegex {
+		filter.ParamValue = req.ParamValue
+		return filter, nil
+	}
+	regex, err := regexp.Compile(req.ParamValue)
+	if err != nil {
+		return filter, status.Errorf(codes.InvalidArgument, "invalid param value regex: %s", err)
+	}
+	filter.ParamValueRegex = regex
+	return filter, nil
+}
+
+func mapStandaloneConfig(config *domain.StandaloneConfig) *api.StandaloneConfig {
+	return &api.StandaloneConfig{
+		Organization: string(config.Org()),
+		Namespace:    config.Namespace(),
+		Name:         config.Name(),
+		Version:      config.Version(),
+		CreatedAt:    config.CreatedAtUTC().String(),
+		ParamSet:     mapParamSet(config.ParamSet()),
+		ContentHash:  config.ContentHash(),
+		Labels:       config.Labels(),
+	}
+}
+
+func mapConfigGroup(config *domain.ConfigGroup) *api.ConfigGroup {
+	return &api.ConfigGroup{
+		Organization: string(config.Org()),
+		Namespace:    config.Namespace(),
+		Name:         config.Name(),
+		Version:      config.Version(),
+		CreatedAt:    config.CreatedAtUTC().String(),
+		ParamSets:    mapParamSets(config.ParamSets()),
+		ContentHash:  config.ContentHash(),
+		Labels:       config.Labels(),
+	}
+}
+
+func mapConfigRef(id *api.ConfigId) domain.ConfigRef {
+	return domain.ConfigRef{
+		Org:       domain.Org(id.Organization),
+		Namespace: id.Namespace,
+		Name:      id.Name,
+		Version:   id.Version,
+	}
+}
+
+func mapSchema(org, namespace string, schema *api.Schema) *quasarapi.ConfigSchemaDetails {
+	if schema == nil {
+		return nil
+	}
+	return &quasarapi.ConfigSchemaDetails{
+		Organization: org,
+		Namespace:    namespace,
+		SchemaName:   schema.Name,
+		Version:      schema.Version,
+	}
+}
+
+func mapPatchOps(operations []*api.PatchOperation) []domain.PatchOp {
+	ops := make([]domain.PatchOp, 0, len(operations))
+	for _, operation := range operations {
+		ops = append(ops, domain.PatchOp{
+			Op:       operation.Op,
+			ParamSet: operation.ParamSet,
+			Key:      operation.Key,
+			Value:    operation.Value,
+		})
+	}
+	return ops
+}
+
+func mapDiffFormat(format api.DiffFormat) (domain.PatchFormat, error) {
+	switch format {
+	case api.DiffFormat_Structured:
+		return domain.PatchFormatNone, nil
+	case api.DiffFormat_JsonPatch:
+		return domain.PatchFormatJSONPatch, nil
+	case api.DiffFormat_MergePatch:
+		return domain.PatchFormatMergePatch, nil
+	case api.DiffFormat_UnifiedText:
+		return domain.PatchFormatUnified, nil
+	default:
+		return domain.PatchFormatNone, status.Errorf(codes.InvalidArgument, "unknown diff format: %s", format)
+	}
+}
+
+func mapViolations(violations []domain.ConstraintViolation) []*api.ConstraintViolation {
+	protoViolations := make([]*api.ConstraintViolation, 0, len(violations))
+	for _, violation := range violations {
+		protoViolations = append(protoViolations, &api.ConstraintViolation{
+			Constraint: violation.Constraint,
+			Message:    violation.Message,
+		})
+	}
+	return protoViolations
+}
+
+func mapDiffs(diffs []domain.Diff) *api.Diffs {
+	protoDiffs := &api.Diffs{
+		Diffs: make([]*api.Diff, 0, len(diffs)),
+	}
+	for _, diff := range diffs {
+		protoDiffs.Diffs = append(protoDiffs.Diffs, mapDiff(diff))
+	}
+	return protoDiffs
+}
+
+func mapDiff(diff domain.Diff) *api.Diff {
+	if diff == nil {
+		return nil
+	}
+	return &api.Diff{Type: string(diff.Type()), Diff: diff.Diff()}
+}
+
+func mapConfigId(ref domain.ConfigRef) *api.ConfigId {
+	return &api.ConfigId{
+		Organization: string(ref.Org),
+		Namespace:    ref.Namespace,
+		Name:         ref.Name,
+		Version:      ref.Version,
+	}
+}
+
+func mapNodePlacement(placement domain.NodePlacement) *api.NodePlacement {
+	return &api.NodePlacement{
+		Type:   placement.Config.Type,
+		Config: mapConfigId(placement.Config),
+		Task:   mapTask(placement.Task),
+	}
+}
+
+func mapConfigDrift(drift domain.ConfigDrift) *api.ConfigDrift {
+	protoDrift := &api.ConfigDrift{
+		Node:         string(drift.Node),
+		Type:         drift.Expected.Type,
+		Expected:     mapConfigId(drift.Expected),
+		ExpectedHash: drift.ExpectedHash,
+		Diffs:        make(map[string]*api.Diffs),
+	}
+	if drift.Applied != nil {
+		protoDrift.Applied = &api.AppliedConfig{
+			Type:        drift.Applied.Config.Type,
+			Config:      mapConfigId(drift.Applied.Config),
+			ContentHash: drift.Applied.ContentHash,
+			TaskId:      drift.Applied.TaskId,
+		}
+		protoDrift.ReportedAt = drift.Applied.ReportedAtUTC().String()
+	}
+	for paramSet, diffs := range drift.Diffs {
+		protoDrift.Diffs[paramSet] = mapDiffs(diffs)
+	}
+	return protoDrift
+}
+
+func mapDiffSide(side *api.DiffSide) services.ParamSetSource {
+	switch source := side.GetSource().(type) {
+	case *api.DiffSide_Standalone:
+		ref := mapConfigRef(source.Standalone)
+		ref.Type = domain.ConfTypeStandalone
+		return services.ParamSetSource{Standalone: &ref}
+	case *api.DiffSide_Group:
+		ref := mapConfigRef(source.Group.Group)
+		ref.Type = domain.ConfTypeGroup
+		return services.ParamSetSource{Group: &ref, ParamSet: source.Group.ParamSet}
+	case *api.DiffSide_Inline:
+		return services.ParamSetSource{Inline: mapProtoParamSet(source.Inline.Name, source.Inline.ParamSet)}
+	default:
+		return services.ParamSetSource{}
+	}
+}
+
+func mapRollout(rollout *domain.Rollout) *api.Rollout {
+	protoRollout := &api.Rollout{
+		Id:              rollout.Id,
+		Kind:            string(rollout.Kind),
+		Type:            rollout.Config.Type,
+		Config:          mapConfigId(rollout.Config),
+		Strategy:        rollout.Strategy,
+		Seed:            rollout.Seed,
+		Status:          string(rollout.Status),
+		Reason:          rollout.Reason,
+		CurrentStage:    int32(rollout.CurrentStage),
+		Stages:          make([]int32, 0, len(rollout.Stages)),
+		TotalNodes:      int32(len(rollout.Nodes)),
+		Dispatched:      int32(rollout.Dispatched),
+		MaxFailureRatio: rollout.MaxFailureRatio,
+		MaxFailures:     int32(rollout.MaxFailures),
+		TimeoutSeconds:  int64(rollout.Timeout.Seconds()),
+		Tasks:           make([]*api.RolloutTask, 0, len(rollout.Tasks)),
+		CreatedAt:       time.Unix(rollout.CreatedAt, 0).UTC().String(),
+		UpdatedAt:       time.Unix(rollout.UpdatedAt, 0).UTC().String(),
+	}
+	for _, stage := range rollout.Stages {
+		protoRollout.Stages = append(protoRollout.Stages, int32(stage))
+	}
+	for _, task := range rollout.Tasks {
+		protoRollout.Tasks = append(protoRollout.Tasks, &api.RolloutTask{
+			Node:   string(task.Node),
+			TaskId: task.TaskId,
+			Stage:  int32(task.Stage),
+			Status: task.Status.String(),
+		})
+	}
+	return protoRollout
+}
+
+func mapPlacementSummary(summary *domain.PlacementSummary) *api.PlacementSummary {
+	protoSummary := &api.PlacementSummary{
+		Total:    int32(summary.Total),
+		Statuses: make(map[string]int32),
+		Resolved: summary.Resolved,
+	}
+	for status, count := range summary.Statuses {
+		protoSummary.Statuses[status.String()] = int32(count)
+	}
+	return protoSummary
+}
+
+func mapPersistentPlacement(placement domain.PersistentPlacement) *api.PersistentPlacement {
+	protoPlacement := &api.PersistentPlacement{
+		Type:            placement.Config.Type,
+		Config:          mapConfigId(placement.Config),
+		Strategy:        &api.PlaceReq_Strategy{},
+		TimeoutSeconds:  int64(placement.Timeout.Seconds()),
+		RemoveUnmatched: placement.RemoveUnmatched,
+		CreatedAt:       time.Unix(placement.CreatedAt, 0).UTC().String(),
+	}
+	if err := proto.Unmarshal(placement.Strategy, protoPlacement.Strategy); err != nil {
+		log.Println(err)
+	}
+	return protoPlacement
+}
+
+func mapMaintenanceWindow(window domain.MaintenanceWindow) *api.MaintenanceWindow {
+	return &api.MaintenanceWindow{
+		Organization:    string(window.Org),
+		Namespace:       window.Namespace,
+		Name:            window.Name,
+		Schedule:        window.Schedule,
+		DurationSeconds: int64(window.Duration.Seconds()),
+		CreatedAt:       time.Unix(window.CreatedAt, 0).UTC().String(),
+	}
+}
+
+func mapScheduledPlacement(placement *domain.ScheduledPlacement) *api.ScheduledPlacement {
+	protoPlacement := &api.ScheduledPlacement{
+		Id:        placement.Id,
+		Type:      placement.Config.Type,
+		Config:    mapConfigId(placement.Config),
+		Status:    string(placement.Status),
+		Reason:    placement.Reason,
+		StartAt:   placement.StartAtUTC().String(),
+		TaskIds:   placement.TaskIds,
+		RolloutId: placement.RolloutId,
+		CreatedAt: time.Unix(placement.CreatedAt, 0).UTC().String(),
+	}
+	if placement.ExecutedAt > 0 {
+		protoPlacement.ExecutedAt = time.Unix(placement.ExecutedAt, 0).UTC().String()
+	}
+	return protoPlacement
+}
+
+func mapDiffStats(stats domain.DiffStats) *api.DiffStats {
+	return &api.DiffStats{
+		Additions:    int32(stats.Additions),
+		Replacements: int32(stats.Replacements),
+		Deletions:    int32(stats.Deletions),
+		Renames:      int32(stats.Renames),
+	}
+}
+
+func mapMergeConflicts(conflicts []domain.MergeConflict) []*api.MergeConflict {
+	protoConflicts := make([]*api.MergeConflict, 0)
+	for _, conflict := range conflicts {
+		protoConflicts = append(protoConflicts, &api.MergeConflict{
+			ParamSet: conflict.ParamSet,
+			Key:      conflict.Key,
+			Ours:     mapDiff(conflict.Ours),
+			Theirs:   mapDiff(conflict.Theirs),
+		})
+	}
+	return protoConflicts
+}
+
 func mapProtoParamSet(name string, params []*api.Param) *domain.NamedParamSet {
 	paramSet := make(map[string]string)
 	for _, param := range params {
@@ -335,16 +1270,48 @@ func mapParamSets(paramSets []domain.NamedParamSet) []*api.NamedParamSet {
 	return protoParamSets
 }
 
+func mapDeadline(task domain.PlacementTask) string {
+	if task.Deadline() == 0 {
+		return ""
+	}
+	return task.DeadlineUTC().String()
+}
+
+func mapProtoTaskStatuses(statusNames []string) ([]domain.PlacementTaskStatus, error) {
+	statuses := make([]domain.PlacementTaskStatus, 0, len(statusNames))
+	for _, statusName := range statusNames {
+		taskStatus, ok := domain.PlacementTaskStatusFromString(statusName)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown placement task status: %s", statusName)
+		}
+		statuses = append(statuses, taskStatus)
+	}
+	return statuses, nil
+}
+
 func mapTasks(tasks []domain.PlacementTask) []*api.PlacementTask {
 	protoTasks := make([]*api.PlacementTask, 0)
 	for _, task := range tasks {
-		protoTasks = append(protoTasks, &api.PlacementTask{
-			Id:         task.Id(),
-			Node:       string(task.Node()),
-			Status:     task.Status().String(),
-			AcceptedAt: task.AcceptedAtUTC().String(),
-			ResolvedAt: task.ResolveddAtUTC().String(),
-		})
+		protoTasks = append(protoTasks, mapTask(task))
 	}
 	return protoTasks
 }
+
+func mapTask(task domain.PlacementTask) *api.PlacementTask {
+	return &api.PlacementTask{
+		Id:             task.Id(),
+		Node:           string(task.Node()),
+		Status:         task.Status().String(),
+		AcceptedAt:     task.AcceptedAtUTC().String(),
+		ResolvedAt:     task.ResolveddAtUTC().String(),
+		RollbackOf:     task.RollbackOf(),
+		SupersededBy:   task.SupersededBy(),
+		Deadline:       mapDeadline(task),
+		Attempts:       int32(task.Attempts()),
+		Reason:         task.Reason(),
+		Seed:           task.Seed(),
+		Progress:       task.Progress(),
+		DurationMillis: task.Duration().Milliseconds(),
+		PlacementId:    task.PlacementId(),
+	}
+}
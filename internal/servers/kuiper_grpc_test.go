@@ -0,0 +1,112 @@
+package servers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/internal/services"
+	"github.com/c12s/kuiper/pkg/api"
+	magnetarapi "github.com/c12s/magnetar/pkg/api"
+	"google.golang.org/grpc"
+)
+
+type fakeStandaloneStore struct {
+	domain.StandaloneConfigStore
+	config *domain.StandaloneConfig
+}
+
+func (s fakeStandaloneStore) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.StandaloneConfig, *domain.Error) {
+	return s.config, nil
+}
+
+type fakeWindowStore struct {
+	domain.MaintenanceWindowStore
+	windows []domain.MaintenanceWindow
+}
+
+func (s fakeWindowStore) ListByOrg(ctx context.Context, org domain.Org) ([]domain.MaintenanceWindow, *domain.Error) {
+	return s.windows, nil
+}
+
+type fakePersistentStore struct {
+	domain.PersistentPlacementStore
+	put []domain.PersistentPlacement
+}
+
+func (s *fakePersistentStore) Put(ctx context.Context, placement domain.PersistentPlacement) *domain.Error {
+	s.put = append(s.put, placement)
+	return nil
+}
+
+func (s *fakePersistentStore) DeleteOtherVersion(ctx context.Context, config domain.ConfigRef) (*domain.PersistentPlacement, *domain.Error) {
+	return nil, nil
+}
+
+// fakeMagnetar owns no nodes, so placements succeed without any tasks.
+type fakeMagnetar struct {
+	magnetarapi.MagnetarClient
+}
+
+func (fakeMagnetar) QueryOrgOwnedNodes(ctx context.Context, in *magnetarapi.QueryOrgOwnedNodesReq, opts ...grpc.CallOption) (*magnetarapi.QueryOrgOwnedNodesResp, error) {
+	return &magnetarapi.QueryOrgOwnedNodesResp{}, nil
+}
+
+func TestPlaceStandaloneConfigPersistent(t *testing.T) {
+	config := domain.NewStandaloneConfig("org", "ns", "v1", *domain.NewParamSet("db", map[string]string{"k": "v"}))
+	// only opens on new year's day
+	closed := domain.MaintenanceWindow{Org: "org", Namespace: "ns", Name: "yearly", Schedule: "0 0 1 1 *", Duration: time.Minute}
+	tests := []struct {
+		name       string
+		persistent bool
+		strategy   string
+		windows    []domain.MaintenanceWindow
+		fails      bool
+		persisted  bool
+	}{
+		{name: "placed", persistent: true, strategy: "default", persisted: true},
+		{name: "not persistent", strategy: "default"},
+		{name: "window closed", persistent: true, strategy: "default", windows: []domain.MaintenanceWindow{closed}, fails: true},
+		{name: "unknown strategy", persistent: true, strategy: "unknown", fails: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authorizer := services.NewAuthZService("test-key")
+			strategies := services.NewPlacementStrategyRegistry()
+			if err := strategies.Register(services.NewQueryPlacementStrategy(fakeMagnetar{})); err != nil {
+				t.Fatal(err)
+			}
+			standalones := fakeStandaloneStore{config: config}
+			persistent := &fakePersistentStore{}
+			placements := services.NewPlacementStore(strategies, nil, nil, authorizer, nil, standalones, nil, "", time.Minute, nil, nil, 3, fakeWindowStore{windows: tt.windows}, persistent, nil, nil, 1, time.Second)
+			s := &KuiperGrpcServer{
+				standalone: services.NewStandaloneConfigService(nil, authorizer, standalones, placements, nil, nil),
+				placements: placements,
+			}
+			ctx, err := authorizer.Impersonate(context.Background(), domain.ServiceIdentity{
+				Org:     "org",
+				Subject: "test",
+				Permissions: []string{
+					"config.get|config|" + services.OortConfigId(domain.ConfTypeStandalone, "org", "ns", "db", "v1"),
+					"namespace.putconfig|namespace|org/ns",
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, placeErr := s.PlaceStandaloneConfig(ctx, &api.PlaceReq{
+				Config:     &api.ConfigId{Organization: "org", Namespace: "ns", Name: "db", Version: "v1"},
+				Strategy:   &api.PlaceReq_Strategy{Name: tt.strategy, Query: []*magnetarapi.Selector{}},
+				Persistent: tt.persistent,
+			})
+			if failed := placeErr != nil; failed != tt.fails {
+				t.Errorf("err = %v, want failure %t", placeErr, tt.fails)
+			}
+			if persisted := len(persistent.put) > 0; persisted != tt.persisted {
+				t.Errorf("persisted = %t, want %t", persisted, tt.persisted)
+			}
+		})
+	}
+}
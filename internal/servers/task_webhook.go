@@ -5,6 +5,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/c12s/kuiper/internal/domain"
 	"github.com/c12s/kuiper/internal/services"
@@ -14,11 +15,13 @@ import (
 
 type TaskWebhooks struct {
 	placements *services.PlacementService
+	drift      *services.DriftService
 }
 
-func NewTaskWebshooks(placements *services.PlacementService) *TaskWebhooks {
+func NewTaskWebshooks(placements *services.PlacementService, drift *services.DriftService) *TaskWebhooks {
 	return &TaskWebhooks{
 		placements: placements,
+		drift:      drift,
 	}
 }
 
@@ -43,14 +46,18 @@ func (tw *TaskWebhooks) UpdateStandaloneConfigTaskStatus(w http.ResponseWriter,
 		return
 	}
 
-	status, mapped := mapStatus(reply.Status)
+	report, mapped := mapTaskReport(reply)
 	if !mapped {
-		log.Printf("could not map status %s", reply.Status)
+		log.Printf("could not map status %s of task %s (remove=%t): %s", reply.Status, reply.Cmd.TaskId, reply.Cmd.Remove, reply.Message)
 		return
 	}
-	updateErr := tw.placements.UpdateStatus(context.Background(), domain.Org(config.Organization), config.Namespace, config.Name, config.Version, domain.ConfTypeStandalone, reply.Cmd.TaskId, status)
+	updateErr := tw.placements.UpdateStatus(context.Background(), domain.Org(config.Organization), config.Namespace, config.Name, config.Version, domain.ConfTypeStandalone, reply.Cmd.TaskId, report)
 	if updateErr != nil {
 		log.Println(updateErr)
+		return
+	}
+	if report.Status == domain.PlacementTaskStatusPlaced {
+		tw.recordApplied(reply, domain.ConfTypeStandalone, config.Organization, config.Namespace, config.Name, config.Version, config.ContentHash)
 	}
 }
 
@@ -75,15 +82,60 @@ func (tw *TaskWebhooks) UpdateConfigGroupTaskStatus(w http.ResponseWriter, r *ht
 		return
 	}
 
-	status, mapped := mapStatus(reply.Status)
+	report, mapped := mapTaskReport(reply)
 	if !mapped {
-		log.Printf("could not map status %s", reply.Status)
+		log.Printf("could not map status %s of task %s (remove=%t): %s", reply.Status, reply.Cmd.TaskId, reply.Cmd.Remove, reply.Message)
 		return
 	}
-	updateErr := tw.placements.UpdateStatus(context.Background(), domain.Org(config.Organization), config.Namespace, config.Name, config.Version, domain.ConfTypeGroup, reply.Cmd.TaskId, status)
+	updateErr := tw.placements.UpdateStatus(context.Background(), domain.Org(config.Organization), config.Namespace, config.Name, config.Version, domain.ConfTypeGroup, reply.Cmd.TaskId, report)
 	if updateErr != nil {
 		log.Println(updateErr)
+		return
+	}
+	if report.Status == domain.PlacementTaskStatusPlaced {
+		tw.recordApplied(reply, domain.ConfTypeGroup, config.Organization, config.Namespace, config.Name, config.Version, config.ContentHash)
+	}
+}
+
+// recordApplied treats a successful apply as the first drift report for the
+// config, so drift is tracked even before the agent's periodic report arrives.
+func (tw *TaskWebhooks) recordApplied(reply *api.ApplyConfigReply, configType, org, namespace, name, version, placedHash string) {
+	if reply.NodeId == "" {
+		return
+	}
+	contentHash := reply.ContentHash
+	if contentHash == "" {
+		contentHash = placedHash
+	}
+	err := tw.drift.RecordApplied(context.Background(), domain.AppliedConfig{
+		Node: domain.Node(reply.NodeId),
+		Config: domain.ConfigRef{
+			Org:       domain.Org(org),
+			Namespace: namespace,
+			Name:      name,
+			Version:   version,
+			Type:      configType,
+		},
+		ContentHash: contentHash,
+		TaskId:      reply.Cmd.TaskId,
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func mapTaskReport(reply *api.ApplyConfigReply) (domain.TaskReport, bool) {
+	status, mapped := mapStatus(reply.Status)
+	if reply.Cmd.Remove {
+		// the config stays placed on the node until the agent removed it
+		status, mapped = domain.PlacementTaskStatusRemoved, reply.Status == api.TaskStatus_Placed
 	}
+	return domain.TaskReport{
+		Status:   status,
+		Reason:   reply.Message,
+		Progress: reply.Progress,
+		Duration: time.Duration(reply.DurationMillis) * time.Millisecond,
+	}, mapped
 }
 
 func mapStatus(protoStatus api.TaskStatus) (domain.PlacementTaskStatus, bool) {
@@ -92,6 +144,10 @@ func mapStatus(protoStatus api.TaskStatus) (domain.PlacementTaskStatus, bool) {
 		return domain.PlacementTaskStatusPlaced, true
 	case api.TaskStatus_Failed:
 		return domain.PlacementTaskStatusFailed, true
+	case api.TaskStatus_InProgress:
+		return domain.PlacementTaskStatusInProgress, true
+	case api.TaskStatus_Rejected:
+		return domain.PlacementTaskStatusRejected, true
 	default:
 		return domain.PlacementTaskStatusFailed, false
 	}
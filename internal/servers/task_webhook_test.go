@@ -0,0 +1,51 @@
+package servers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/pkg/api"
+)
+
+func TestMapTaskReport(t *testing.T) {
+	tests := []struct {
+		name   string
+		status api.TaskStatus
+		remove bool
+		want   domain.PlacementTaskStatus
+		mapped bool
+	}{
+		{name: "placed", status: api.TaskStatus_Placed, want: domain.PlacementTaskStatusPlaced, mapped: true},
+		{name: "failed", status: api.TaskStatus_Failed, want: domain.PlacementTaskStatusFailed, mapped: true},
+		{name: "in progress", status: api.TaskStatus_InProgress, want: domain.PlacementTaskStatusInProgress, mapped: true},
+		{name: "rejected", status: api.TaskStatus_Rejected, want: domain.PlacementTaskStatusRejected, mapped: true},
+		{name: "unknown status", status: api.TaskStatus(42)},
+		{name: "removed", status: api.TaskStatus_Placed, remove: true, want: domain.PlacementTaskStatusRemoved, mapped: true},
+		{name: "removal failed", status: api.TaskStatus_Failed, remove: true},
+		{name: "removal rejected", status: api.TaskStatus_Rejected, remove: true},
+		{name: "removal in progress", status: api.TaskStatus_InProgress, remove: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reply := &api.ApplyConfigReply{
+				Cmd:            &api.ApplyConfigCommand{TaskId: "t1", Remove: tt.remove},
+				Status:         tt.status,
+				Message:        "message",
+				Progress:       40,
+				DurationMillis: 1500,
+			}
+			report, mapped := mapTaskReport(reply)
+			if mapped != tt.mapped {
+				t.Fatalf("mapped = %t, want %t", mapped, tt.mapped)
+			}
+			if !mapped {
+				return
+			}
+			want := domain.TaskReport{Status: tt.want, Reason: "message", Progress: 40, Duration: 1500 * time.Millisecond}
+			if report != want {
+				t.Errorf("report = %+v, want %+v", report, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,446 @@
+package servers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/pkg/api"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxIdentifierLen = 63
+	maxVersionLen    = 128
+)
+
+var (
+	identifierRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+	versionRegex    = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.+-]*$`)
+)
+
+type validator struct {
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func (v *validator) identifier(field, value string) {
+	v.match(field, value, identifierRegex, maxIdentifierLen, "letters, digits, '_', '.' and '-'")
+}
+
+func (v *validator) version(field, value string) {
+	v.match(field, value, versionRegex, maxVersionLen, "letters, digits, '_', '.', '+' and '-'")
+}
+
+func (v *validator) match(field, value string, regex *regexp.Regexp, maxLen int, allowed string) {
+	switch {
+	case value == "":
+		v.violation(field, "must not be empty")
+	case len(value) > maxLen:
+		v.violation(field, fmt.Sprintf("must be at most %d characters long", maxLen))
+	case !regex.MatchString(value):
+		v.violation(field, fmt.Sprintf("must start with a letter or digit and contain only %s", allowed))
+	}
+}
+
+func (v *validator) labels(field string, labels map[string]string) {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		v.identifier(fmt.Sprintf("%s[%s]", field, key), key)
+	}
+}
+
+func (v *validator) configId(field string, id *api.ConfigId) {
+	if id == nil {
+		v.violation(field, "is required")
+		return
+	}
+	v.identifier(field+".organization", id.Organization)
+	v.identifier(field+".namespace", id.Namespace)
+	v.identifier(field+".name", id.Name)
+	v.version(field+".version", id.Version)
+}
+
+func (v *validator) diffSide(field string, side *api.DiffSide) {
+	switch source := side.GetSource().(type) {
+	case *api.DiffSide_Standalone:
+		v.configId(field+".standalone", source.Standalone)
+	case *api.DiffSide_Group:
+		if source.Group == nil {
+			v.violation(field+".group", "is required")
+			return
+		}
+		v.configId(field+".group.group", source.Group.Group)
+		v.identifier(field+".group.paramSet", source.Group.ParamSet)
+	case *api.DiffSide_Inline:
+		if source.Inline == nil {
+			v.violation(field+".inline", "is required")
+		}
+	default:
+		v.violation(field, "one of standalone, group or inline is required")
+	}
+}
+
+// an empty namespace identifies an org-wide window
+func (v *validator) maintenanceWindowId(organization, namespace, name string) {
+	v.identifier("organization", organization)
+	if namespace != "" {
+		v.identifier("namespace", namespace)
+	}
+	v.identifier("name", name)
+}
+
+func (v *validator) violation(field, description string) {
+	v.violations = append(v.violations, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+}
+
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid request: %s %s", v.violations[0].Field, v.violations[0].Description))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v.violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func validateConfigId(req *api.ConfigId) error {
+	v := &validator{}
+	if req == nil {
+		v.violation("config", "is required")
+		return v.err()
+	}
+	v.identifier("organization", req.Organization)
+	v.identifier("namespace", req.Namespace)
+	v.identifier("name", req.Name)
+	v.version("version", req.Version)
+	return v.err()
+}
+
+func validateListPlacementTaskReq(req *api.ListPlacementTaskReq) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	v.identifier("namespace", req.Namespace)
+	v.identifier("name", req.Name)
+	v.version("version", req.Version)
+	return v.err()
+}
+
+func validateWatchPlacementReq(req *api.WatchPlacementReq) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	v.identifier("namespace", req.Namespace)
+	v.identifier("name", req.Name)
+	v.version("version", req.Version)
+	if req.TimeoutSeconds < 0 {
+		v.violation("timeoutSeconds", "must not be negative")
+	}
+	return v.err()
+}
+
+func validateCancelPlacementReq(req *api.CancelPlacementReq) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	v.identifier("namespace", req.Namespace)
+	v.identifier("name", req.Name)
+	v.version("version", req.Version)
+	for i, taskId := range req.TaskIds {
+		if taskId == "" {
+			v.violation(fmt.Sprintf("taskIds[%d]", i), "must not be empty")
+		}
+	}
+	return v.err()
+}
+
+func validateNewStandaloneConfig(req *api.NewStandaloneConfig) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	v.identifier("namespace", req.Namespace)
+	v.identifier("name", req.Name)
+	v.version("version", req.Version)
+	v.labels("labels", req.Labels)
+	return v.err()
+}
+
+func validateNewConfigGroup(req *api.NewConfigGroup) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	v.identifier("namespace", req.Namespace)
+	v.identifier("name", req.Name)
+	v.version("version", req.Version)
+	v.labels("labels", req.Labels)
+	for i, paramSet := range req.ParamSets {
+		v.identifier(fmt.Sprintf("paramSets[%d].name", i), paramSet.Name)
+	}
+	return v.err()
+}
+
+func validateListReq(organization, namespace string) error {
+	v := &validator{}
+	v.identifier("organization", organization)
+	v.identifier("namespace", namespace)
+	return v.err()
+}
+
+func validateSearchReq(req *api.SearchConfigReq) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	for i, namespace := range req.Namespaces {
+		v.identifier(fmt.Sprintf("namespaces[%d]", i), namespace)
+	}
+	v.labels("labels", req.Labels)
+	return v.err()
+}
+
+func validateDiffReq(req *api.DiffReq) error {
+	v := &validator{}
+	v.configId("reference", req.Reference)
+	v.configId("diff", req.Diff)
+	return v.err()
+}
+
+func validateMergeReq(req *api.MergeReq) error {
+	v := &validator{}
+	v.configId("base", req.Base)
+	v.configId("ours", req.Ours)
+	v.configId("theirs", req.Theirs)
+	if req.Save || req.Version != "" {
+		v.version("version", req.Version)
+	}
+	return v.err()
+}
+
+// validatePatchReq validates a patch of a standalone config or, if group is
+// set, of a config group, whose operations must name the param set they patch.
+func validatePatchReq(req *api.PatchReq, group bool) error {
+	v := &validator{}
+	v.configId("source", req.Source)
+	v.version("version", req.Version)
+	for i, operation := range req.Operations {
+		if operation.Op != domain.PatchOpSet && operation.Op != domain.PatchOpUnset {
+			v.violation(fmt.Sprintf("operations[%d].op", i), fmt.Sprintf("must be %s or %s", domain.PatchOpSet, domain.PatchOpUnset))
+		}
+		if operation.Key == "" && (operation.Op == domain.PatchOpSet || !group) {
+			v.violation(fmt.Sprintf("operations[%d].key", i), "must not be empty")
+		}
+		if group {
+			v.identifier(fmt.Sprintf("operations[%d].paramSet", i), operation.ParamSet)
+		}
+	}
+	return v.err()
+}
+
+func validatePlaceReq(req *api.PlaceReq) error {
+	v := &validator{}
+	v.configId("config", req.Config)
+	if req.Strategy == nil {
+		v.violation("strategy", "is required")
+	}
+	if constraints := req.Strategy.GetConstraints(); constraints != nil {
+		for i, maxPerLabel := range constraints.MaxPerLabel {
+			if maxPerLabel.Label == "" {
+				v.violation(fmt.Sprintf("strategy.constraints.maxPerLabel[%d].label", i), "must not be empty")
+			}
+			if maxPerLabel.Max <= 0 {
+				v.violation(fmt.Sprintf("strategy.constraints.maxPerLabel[%d].max", i), "must be positive")
+			}
+		}
+		for i, ref := range constraints.AntiAffinity {
+			if ref.Type != domain.ConfTypeStandalone && ref.Type != domain.ConfTypeGroup {
+				v.violation(fmt.Sprintf("strategy.constraints.antiAffinity[%d].type", i), fmt.Sprintf("must be %s or %s", domain.ConfTypeStandalone, domain.ConfTypeGroup))
+			}
+			v.identifier(fmt.Sprintf("strategy.constraints.antiAffinity[%d].namespace", i), ref.Namespace)
+			v.identifier(fmt.Sprintf("strategy.constraints.antiAffinity[%d].name", i), ref.Name)
+		}
+	}
+	if req.TimeoutSeconds < 0 {
+		v.violation("timeoutSeconds", "must not be negative")
+	}
+	if req.Persistent && (req.Rollout != nil || req.Rolling != nil) {
+		v.violation("persistent", "can't be combined with a staged rollout")
+	}
+	if req.RemoveUnmatched && !req.Persistent {
+		v.violation("removeUnmatched", "requires persistent")
+	}
+	if req.Async && (req.Rollout != nil || req.Rolling != nil || req.DryRun) {
+		v.violation("async", "can't be combined with a staged rollout or a dry run")
+	}
+	if req.Rollout != nil {
+		prev := int32(0)
+		for i, stage := range req.Rollout.Stages {
+			if stage <= prev || stage > 100 {
+				v.violation(fmt.Sprintf("rollout.stages[%d]", i), "must be increasing percentages between 1 and 100")
+			}
+			prev = stage
+		}
+		if len(req.Rollout.Stages) > 0 && prev != 100 {
+			v.violation("rollout.stages", "last stage must be 100")
+		}
+		if req.Rollout.MaxFailureRatio < 0 || req.Rollout.MaxFailureRatio > 1 {
+			v.violation("rollout.maxFailureRatio", "must be between 0 and 1")
+		}
+	}
+	if req.Rolling != nil {
+		if req.Rollout != nil {
+			v.violation("rolling", "can't be combined with rollout")
+		}
+		switch batch := req.Rolling.Batch.(type) {
+		case *api.PlaceReq_Rolling_BatchSize:
+			if batch.BatchSize <= 0 {
+				v.violation("rolling.batchSize", "must be positive")
+			}
+		case *api.PlaceReq_Rolling_BatchPercentage:
+			if batch.BatchPercentage <= 0 || batch.BatchPercentage > 100 {
+				v.violation("rolling.batchPercentage", "must be between 1 and 100")
+			}
+		default:
+			v.violation("rolling", "one of batchSize or batchPercentage is required")
+		}
+		if req.Rolling.MaxFailures < 0 {
+			v.violation("rolling.maxFailures", "must not be negative")
+		}
+	}
+	return v.err()
+}
+
+func validateListPlacementsByNodeReq(req *api.ListPlacementsByNodeReq) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	v.identifier("node", req.Node)
+	return v.err()
+}
+
+func validateListDriftReq(req *api.ListDriftReq) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	if req.Namespace != "" {
+		v.identifier("namespace", req.Namespace)
+	}
+	if req.Node != "" {
+		v.identifier("node", req.Node)
+	}
+	return v.err()
+}
+
+func validateDiffParamSetsReq(req *api.DiffParamSetsReq) error {
+	v := &validator{}
+	v.diffSide("reference", req.Reference)
+	v.diffSide("diff", req.Diff)
+	return v.err()
+}
+
+func validateRolloutId(organization, id string) error {
+	v := &validator{}
+	v.identifier("organization", organization)
+	if id == "" {
+		v.violation("id", "must not be empty")
+	}
+	return v.err()
+}
+
+func validateListRolloutsReq(req *api.ListRolloutsReq) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	if req.Namespace != "" {
+		v.identifier("namespace", req.Namespace)
+	}
+	return v.err()
+}
+
+func validateMaintenanceWindow(req *api.MaintenanceWindow) error {
+	v := &validator{}
+	v.maintenanceWindowId(req.Organization, req.Namespace, req.Name)
+	if req.Schedule == "" {
+		v.violation("schedule", "must not be empty")
+	} else if _, err := domain.ParseCronSchedule(req.Schedule); err != nil {
+		v.violation("schedule", err.Error())
+	}
+	if req.DurationSeconds < 60 || time.Duration(req.DurationSeconds)*time.Second > domain.MaxMaintenanceWindowDuration {
+		v.violation("durationSeconds", fmt.Sprintf("must be between 60 and %d", int64(domain.MaxMaintenanceWindowDuration.Seconds())))
+	}
+	return v.err()
+}
+
+func validateDeleteMaintenanceWindowReq(req *api.MaintenanceWindowId) error {
+	v := &validator{}
+	v.maintenanceWindowId(req.Organization, req.Namespace, req.Name)
+	return v.err()
+}
+
+func validateListMaintenanceWindowsReq(req *api.ListMaintenanceWindowsReq) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	if req.Namespace != "" {
+		v.identifier("namespace", req.Namespace)
+	}
+	return v.err()
+}
+
+func validateSchedulePlacementReq(req *api.SchedulePlacementReq) error {
+	if req.Placement == nil {
+		v := &validator{}
+		v.violation("placement", "is required")
+		return v.err()
+	}
+	if err := validatePlaceReq(req.Placement); err != nil {
+		return err
+	}
+	v := &validator{}
+	if req.Placement.DryRun {
+		v.violation("placement.dryRun", "can't be scheduled")
+	}
+	if req.StartAt <= 0 {
+		v.violation("startAt", "must be positive")
+	}
+	return v.err()
+}
+
+func validateScheduledPlacementId(req *api.ScheduledPlacementId) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	if req.Id == "" {
+		v.violation("id", "must not be empty")
+	}
+	return v.err()
+}
+
+func validateListScheduledPlacementsReq(req *api.ListScheduledPlacementsReq) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	if req.Namespace != "" {
+		v.identifier("namespace", req.Namespace)
+	}
+	return v.err()
+}
+
+func validatePersistentPlacementId(req *api.PersistentPlacementId) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	if req.Type != domain.ConfTypeStandalone && req.Type != domain.ConfTypeGroup {
+		v.violation("type", fmt.Sprintf("must be %s or %s", domain.ConfTypeStandalone, domain.ConfTypeGroup))
+	}
+	v.identifier("namespace", req.Namespace)
+	v.identifier("name", req.Name)
+	return v.err()
+}
+
+func validateListPersistentPlacementsReq(req *api.ListPersistentPlacementsReq) error {
+	v := &validator{}
+	v.identifier("organization", req.Organization)
+	if req.Namespace != "" {
+		v.identifier("namespace", req.Namespace)
+	}
+	return v.err()
+}
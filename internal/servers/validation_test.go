@@ -0,0 +1,147 @@
+package servers
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/c12s/kuiper/pkg/api"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// violatedFields returns the fields an InvalidArgument error reports.
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("err = %v, want an InvalidArgument status", err)
+	}
+	fields := make([]string, 0)
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func TestValidateConfigId(t *testing.T) {
+	valid := func() *api.ConfigId {
+		return &api.ConfigId{Organization: "org", Namespace: "default", Name: "db", Version: "v1.0.0+build.1"}
+	}
+	tests := []struct {
+		name   string
+		change func(id *api.ConfigId)
+		fields []string
+	}{
+		{
+			name:   "valid",
+			change: func(id *api.ConfigId) {},
+		},
+		{
+			name:   "slash in the name",
+			change: func(id *api.ConfigId) { id.Name = "a/b" },
+			fields: []string{"name"},
+		},
+		{
+			name:   "empty namespace",
+			change: func(id *api.ConfigId) { id.Namespace = "" },
+			fields: []string{"namespace"},
+		},
+		{
+			name:   "leading dot",
+			change: func(id *api.ConfigId) { id.Organization = ".org" },
+			fields: []string{"organization"},
+		},
+		{
+			name:   "too long",
+			change: func(id *api.ConfigId) { id.Name = strings.Repeat("a", maxIdentifierLen+1) },
+			fields: []string{"name"},
+		},
+		{
+			name:   "longest allowed",
+			change: func(id *api.ConfigId) { id.Name = strings.Repeat("a", maxIdentifierLen) },
+		},
+		{
+			name:   "plus is only allowed in versions",
+			change: func(id *api.ConfigId) { id.Name = "a+b"; id.Version = "v1+b" },
+			fields: []string{"name"},
+		},
+		{
+			name:   "every field reported",
+			change: func(id *api.ConfigId) { *id = api.ConfigId{} },
+			fields: []string{"organization", "namespace", "name", "version"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := valid()
+			tt.change(id)
+			fields := violatedFields(t, validateConfigId(id))
+			if !slices.Equal(fields, tt.fields) {
+				t.Errorf("violations = %v, want %v", fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestValidatePatchReq(t *testing.T) {
+	tests := []struct {
+		name       string
+		group      bool
+		operations []*api.PatchOperation
+		fields     []string
+	}{
+		{
+			name:       "set and unset",
+			operations: []*api.PatchOperation{{Op: "set", Key: "a", Value: "1"}, {Op: "unset", Key: "b"}},
+		},
+		{
+			name:       "unknown operation",
+			operations: []*api.PatchOperation{{Op: "rename", Key: "a"}},
+			fields:     []string{"operations[0].op"},
+		},
+		{
+			name:       "standalone unset needs a key",
+			operations: []*api.PatchOperation{{Op: "set", Key: "a"}, {Op: "unset"}},
+			fields:     []string{"operations[1].key"},
+		},
+		{
+			name:       "group unset without a key removes the param set",
+			group:      true,
+			operations: []*api.PatchOperation{{Op: "unset", ParamSet: "db"}},
+		},
+		{
+			name:       "group set needs a key",
+			group:      true,
+			operations: []*api.PatchOperation{{Op: "set", ParamSet: "db"}},
+			fields:     []string{"operations[0].key"},
+		},
+		{
+			name:       "group param set is an identifier",
+			group:      true,
+			operations: []*api.PatchOperation{{Op: "set", ParamSet: "a/b", Key: "k"}, {Op: "unset", Key: "k"}},
+			fields:     []string{"operations[0].paramSet", "operations[1].paramSet"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &api.PatchReq{
+				Source:     &api.ConfigId{Organization: "org", Namespace: "default", Name: "db", Version: "v1"},
+				Version:    "v2",
+				Operations: tt.operations,
+			}
+			fields := violatedFields(t, validatePatchReq(req, tt.group))
+			if !slices.Equal(fields, tt.fields) {
+				t.Errorf("violations = %v, want %v", fields, tt.fields)
+			}
+		})
+	}
+}
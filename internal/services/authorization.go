@@ -5,14 +5,19 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/c12s/kuiper/internal/domain"
 	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
 	PermConfigGet = "config.get"
 	PermConfigPut = "config.put"
 	PermNsPut     = "namespace.putconfig"
+	// allows placing configs outside the namespace's maintenance windows
+	PermNsPlaceOutsideWindow = "namespace.placeoutsidewindow"
 )
 
 const (
@@ -21,6 +26,9 @@ const (
 	OortResNamespace = "namespace"
 )
 
+// lifetime of the tokens background work runs with
+const serviceTokenTTL = 5 * time.Minute
+
 func OortConfigId(configType, org, namespace, name, version string) string {
 	return fmt.Sprintf("%s/%s/%s/%s/%s", configType, org, namespace, name, version)
 }
@@ -34,39 +42,141 @@ func NewAuthZService(key string) *AuthZService {
 }
 
 func (s *AuthZService) Authorize(ctx context.Context, permName string, objKind string, objId string) bool {
+	permissions, ok := s.permissions(ctx)
+	if !ok {
+		return false
+	}
+
+	reqPerm := fmt.Sprintf("%s|%s|%s", permName, objKind, objId)
+	for _, perm := range permissions {
+		if perm == reqPerm {
+			return true
+		}
+	}
+
+	log.Println("required permission not found")
+	return false
+}
+
+func (s *AuthZService) claims(ctx context.Context) (jwt.MapClaims, bool) {
 	tokenString, ok := ctx.Value("authz-token").(string)
 	if !ok {
 		log.Println("no token provided")
-		return false
+		return nil, false
 	}
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		return []byte(s.key), nil
 	})
 	if err != nil {
 		log.Printf("Error parsing token: %v", err)
-		return false
+		return nil, false
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		log.Println("Invalid claims type.")
+		return nil, false
+	}
+	return claims, true
+}
+
+func (s *AuthZService) permissions(ctx context.Context) ([]string, bool) {
+	claims, ok := s.claims(ctx)
+	if !ok {
+		return nil, false
+	}
+	permissionsClaim, ok := claims["permissions"].(string)
+	if !ok {
+		log.Println("Custom Claim permissions is not a string or does not exist.")
+		return nil, false
 	}
+	return strings.Split(permissionsClaim, ","), true
+}
 
-	var permissions []string
-	if claims, ok := token.Claims.(jwt.MapClaims); ok {
-		if permissionsClaim, ok := claims["permissions"].(string); ok {
-			permissions = strings.Split(permissionsClaim, ",")
-		} else {
-			log.Println("Custom Claim permissions is not a string or does not exist.")
-			return false
+// Identity captures the caller's permissions on org, so that background work
+// the caller requested, such as a scheduled placement, can later run with
+// them through Impersonate until the caller's token expires.
+func (s *AuthZService) Identity(ctx context.Context, org domain.Org) (domain.ServiceIdentity, *domain.Error) {
+	permissions, ok := s.permissions(ctx)
+	if !ok {
+		return domain.ServiceIdentity{}, domain.NewError(domain.ErrTypeUnauthorized, "no valid token provided")
+	}
+	claims, _ := s.claims(ctx)
+	subject, _ := claims.GetSubject()
+	identity := domain.ServiceIdentity{
+		Org:         org,
+		Subject:     subject,
+		Permissions: make([]string, 0),
+	}
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		identity.ExpiresAt = exp.Unix()
+	}
+	for _, perm := range permissions {
+		if permissionInOrg(perm, org) {
+			identity.Permissions = append(identity.Permissions, perm)
 		}
-	} else {
-		log.Println("Invalid claims type.")
+	}
+	return identity, nil
+}
+
+// Impersonate returns a context authorized with the identity's permissions,
+// both for Kuiper itself and for the services it calls. It fails once the
+// token the identity was captured from has expired, and the token it signs
+// doesn't outlive it.
+func (s *AuthZService) Impersonate(ctx context.Context, identity domain.ServiceIdentity) (context.Context, *domain.Error) {
+	now := time.Now()
+	if identity.Expired(now) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("token of %s expired at %s", identity.Subject, time.Unix(identity.ExpiresAt, 0).UTC()))
+	}
+	expiresAt := now.Add(serviceTokenTTL).Unix()
+	if identity.ExpiresAt > 0 {
+		expiresAt = min(expiresAt, identity.ExpiresAt)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":         identity.Subject,
+		"permissions": strings.Join(identity.Permissions, ","),
+		"exp":         expiresAt,
+	})
+	signed, err := token.SignedString([]byte(s.key))
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeInternal, err.Error())
+	}
+	ctx = context.WithValue(ctx, "authz-token", signed)
+	return metadata.AppendToOutgoingContext(ctx, "authz-token", signed), nil
+}
+
+// permissionInOrg reports whether a "perm|kind|id" permission is granted on
+// org or on one of its namespaces or configs.
+func permissionInOrg(perm string, org domain.Org) bool {
+	parts := strings.SplitN(perm, "|", 3)
+	if len(parts) != 3 {
 		return false
 	}
+	id := strings.Split(parts[2], "/")
+	switch parts[1] {
+	case OortResOrg:
+		return parts[2] == string(org)
+	case OortResNamespace:
+		return len(id) > 1 && id[0] == string(org)
+	case OortResConfig:
+		return len(id) > 2 && id[1] == string(org)
+	default:
+		return false
+	}
+}
 
-	reqPerm := fmt.Sprintf("%s|%s|%s", permName, objKind, objId)
-	for _, perm := range permissions {
-		if perm == reqPerm {
+// NamespaceAccess returns a predicate reporting whether the caller holds permName
+// for a namespace of org, either directly or through an org wide grant.
+func (s *AuthZService) NamespaceAccess(ctx context.Context, permName string, org string) func(namespace string) bool {
+	orgWide := s.Authorize(ctx, permName, OortResOrg, org)
+	allowed := make(map[string]bool)
+	return func(namespace string) bool {
+		if orgWide {
 			return true
 		}
+		if ok, checked := allowed[namespace]; checked {
+			return ok
+		}
+		allowed[namespace] = s.Authorize(ctx, permName, OortResNamespace, fmt.Sprintf("%s/%s", org, namespace))
+		return allowed[namespace]
 	}
-
-	log.Println("required permission not found")
-	return false
 }
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+func TestPermissionInOrg(t *testing.T) {
+	tests := []struct {
+		name string
+		perm string
+		in   bool
+	}{
+		{name: "org", perm: "config.put|org|org", in: true},
+		{name: "other org", perm: "config.put|org|other", in: false},
+		{name: "org sharing a prefix", perm: "config.put|org|org2", in: false},
+		{name: "namespace", perm: "config.put|namespace|org/ns", in: true},
+		{name: "namespace of another org", perm: "config.put|namespace|other/ns", in: false},
+		{name: "namespace without an org", perm: "config.put|namespace|org", in: false},
+		{name: "config", perm: "config.put|config|standalone/org/ns/db/v1", in: true},
+		{name: "config of another org", perm: "config.put|config|standalone/other/ns/db/v1", in: false},
+		{name: "config without an org", perm: "config.put|config|standalone", in: false},
+		{name: "unknown kind", perm: "config.put|node|org", in: false},
+		{name: "malformed", perm: "config.put|org", in: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := permissionInOrg(tt.perm, "org"); got != tt.in {
+				t.Errorf("permissionInOrg(%q) = %t, want %t", tt.perm, got, tt.in)
+			}
+		})
+	}
+}
+
+func TestImpersonateExpiry(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		expiresAt int64
+		fails     bool
+		// latest the signed token may expire at
+		maxExp int64
+	}{
+		{name: "token without expiry", maxExp: now.Add(serviceTokenTTL + time.Second).Unix()},
+		{name: "expires after the service token", expiresAt: now.Add(time.Hour).Unix(), maxExp: now.Add(serviceTokenTTL + time.Second).Unix()},
+		{name: "expires before the service token", expiresAt: now.Add(time.Minute).Unix(), maxExp: now.Add(time.Minute).Unix()},
+		{name: "expired", expiresAt: now.Add(-time.Second).Unix(), fails: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewAuthZService("test-key")
+			identity := domain.ServiceIdentity{Org: "org", Subject: "test", Permissions: []string{"config.get|org|org"}, ExpiresAt: tt.expiresAt}
+			ctx, err := s.Impersonate(context.Background(), identity)
+			if tt.fails {
+				if err == nil || err.ErrType() != domain.ErrTypeUnauthorized {
+					t.Fatalf("err = %v, want unauthorized", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			claims, ok := s.claims(ctx)
+			if !ok {
+				t.Fatal("impersonated context has no valid token")
+			}
+			exp, _ := claims.GetExpirationTime()
+			if exp == nil || exp.Unix() > tt.maxExp {
+				t.Errorf("token expires at %v, want by %s", exp, time.Unix(tt.maxExp, 0))
+			}
+			// the identity captured from it keeps the limit
+			captured, err := s.Identity(ctx, "org")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if captured.ExpiresAt != exp.Unix() {
+				t.Errorf("captured identity expires at %d, want %d", captured.ExpiresAt, exp.Unix())
+			}
+		})
+	}
+}
@@ -11,7 +11,6 @@ import (
 	oortapi "github.com/c12s/oort/pkg/api"
 	quasarapi "github.com/c12s/quasar/proto"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/protobuf/proto"
 	"gopkg.in/yaml.v3"
 )
 
@@ -104,6 +103,13 @@ func (s *ConfigGroupService) List(ctx context.Context, org domain.Org, namespace
 	return s.store.List(ctx, org, namespace)
 }
 
+func (s *ConfigGroupService) Search(ctx context.Context, org domain.Org, filter domain.ConfigFilter, pageSize int32, pageToken string) ([]*domain.ConfigGroup, string, *domain.Error) {
+	canRead := s.authorizer.NamespaceAccess(ctx, PermConfigGet, string(org))
+	return s.store.Search(ctx, org, pageToken, pageLimit(pageSize), func(config *domain.ConfigGroup) bool {
+		return filter.MatchConfig(config) && canRead(config.Namespace()) && filter.MatchParamSets(config.ParamSets())
+	})
+}
+
 func (s *ConfigGroupService) Delete(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
 	if !s.authorizer.Authorize(ctx, PermConfigPut, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(org), namespace, name, version)) {
 		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigPut))
@@ -111,69 +117,74 @@ func (s *ConfigGroupService) Delete(ctx context.Context, org domain.Org, namespa
 	return s.store.Delete(ctx, org, namespace, name, version)
 }
 
-func (s *ConfigGroupService) Diff(ctx context.Context, referenceOrg domain.Org, referenceNamespace, referenceName, referenceVersion string, diffOrg domain.Org, diffNamespace, diffName, diffVersion string) (map[string][]domain.Diff, *domain.Error) {
+func (s *ConfigGroupService) Diff(ctx context.Context, referenceOrg domain.Org, referenceNamespace, referenceName, referenceVersion string, diffOrg domain.Org, diffNamespace, diffName, diffVersion string, format domain.PatchFormat) (map[string][]domain.Diff, string, *domain.Error) {
 	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(referenceOrg), referenceNamespace, referenceName, referenceVersion)) {
-		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+		return nil, "", domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
 	}
 	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(diffOrg), diffNamespace, diffName, diffVersion)) {
-		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+		return nil, "", domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
 	}
 	reference, err := s.store.Get(ctx, referenceOrg, referenceNamespace, referenceName, referenceVersion)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	diff, err := s.store.Get(ctx, diffOrg, diffNamespace, diffName, diffVersion)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	patch, err := groupPatch(reference, diff, format)
+	if err != nil {
+		return nil, "", err
 	}
-	return diff.Diff(reference), nil
+	return diff.Diff(reference), patch, nil
 }
 
-func (s *ConfigGroupService) Place(ctx context.Context, org domain.Org, namespace, name, version string, strategy *api.PlaceReq_Strategy) ([]domain.PlacementTask, *domain.Error) {
-	config, err := s.store.Get(ctx, org, namespace, name, version)
+func (s *ConfigGroupService) Merge(ctx context.Context, base, ours, theirs domain.ConfigRef, save bool, version string, schema *quasarapi.ConfigSchemaDetails) (*domain.ConfigGroup, []domain.MergeConflict, bool, *domain.Error) {
+	configs := make([]*domain.ConfigGroup, 0, 3)
+	for _, ref := range []domain.ConfigRef{base, ours, theirs} {
+		config, err := s.Get(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		configs = append(configs, config)
+	}
+	merged, conflicts := configs[0].Merge(configs[1], configs[2], version)
+	if !save || len(conflicts) > 0 {
+		return merged, conflicts, false, nil
+	}
+	merged, err := s.Put(ctx, merged, schema)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return merged, conflicts, true, nil
+}
+
+func (s *ConfigGroupService) Patch(ctx context.Context, org domain.Org, namespace, name, version, targetVersion string, ops []domain.PatchOp, jsonPatch []byte, expectedHash string, schema *quasarapi.ConfigSchemaDetails) (*domain.ConfigGroup, *domain.Error) {
+	source, err := s.Get(ctx, org, namespace, name, version)
 	if err != nil {
 		return nil, err
 	}
-	return s.placements.Place(ctx, config, strategy, func(taskId string) ([]byte, *domain.Error) {
-		config := &api.ConfigGroup{
-			Organization: string(config.Org()),
-			Namespace:    config.Namespace(),
-			Name:         config.Name(),
-			Version:      config.Version(),
-			CreatedAt:    config.CreatedAtUTC().String(),
-			ParamSets:    mapParamSets(config.ParamSets()),
-		}
-		configMarshalled, err := proto.Marshal(config)
-		if err != nil {
-			return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
-		}
-		cmd := &api.ApplyConfigCommand{
-			TaskId:    taskId,
-			Namespace: namespace,
-			Config:    configMarshalled,
-			Type:      "group",
-			Strategy:  strategy.Name,
-		}
-		cmdMarshalled, err := proto.Marshal(cmd)
-		if err != nil {
-			return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
-		}
-		return cmdMarshalled, nil
-	}, "/groups")
+	if expectedHash != "" && expectedHash != source.ContentHash() {
+		return nil, domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) content hash doesn't match the expected one", org, name, version))
+	}
+	patched, err := source.Patch(targetVersion, ops, jsonPatch)
+	if err != nil {
+		return nil, err
+	}
+	return s.Put(ctx, patched, schema)
 }
 
-func (s *ConfigGroupService) ListPlacementTasks(ctx context.Context, org domain.Org, namespace, name, version string) ([]domain.PlacementTask, *domain.Error) {
-	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(org), namespace, name, version)) {
-		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+func (s *ConfigGroupService) Place(ctx context.Context, org domain.Org, namespace, name, version string, strategy *api.PlaceReq_Strategy, timeout time.Duration, async bool) (string, []domain.PlacementTask, []domain.ConstraintViolation, *domain.Error) {
+	config, err := s.store.Get(ctx, org, namespace, name, version)
+	if err != nil {
+		return "", nil, nil, err
 	}
-	return s.placements.List(ctx, org, namespace, name, version, domain.ConfTypeGroup)
+	return s.placements.Place(ctx, config, strategy, timeout, async)
 }
 
-func mapParamSets(paramSets []domain.NamedParamSet) []*api.NamedParamSet {
-	protoParamSets := make([]*api.NamedParamSet, 0)
-	for _, paramSet := range paramSets {
-		params := mapParamSet(paramSet.ParamSet())
-		protoParamSets = append(protoParamSets, &api.NamedParamSet{Name: paramSet.Name(), ParamSet: params})
+func (s *ConfigGroupService) ListPlacementTasks(ctx context.Context, org domain.Org, namespace, name, version, placementId string, statuses []domain.PlacementTaskStatus) ([]domain.PlacementTask, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(org), namespace, name, version)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
 	}
-	return protoParamSets
+	return s.placements.List(ctx, org, namespace, name, version, domain.ConfTypeGroup, placementId, statuses)
 }
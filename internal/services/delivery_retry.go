@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+const (
+	retryBaseDelay = 2 * time.Second
+	retryMaxDelay  = 5 * time.Minute
+)
+
+// RetryDeliveries sends the configs that couldn't be handed to the agent
+// queue again once their backoff passed. It is meant to be run periodically
+// by a single Kuiper instance.
+func (s *PlacementService) RetryDeliveries(ctx context.Context) {
+	retries, err := s.retries.ListDue(ctx, time.Now())
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, retry := range retries {
+		task, err := s.store.Get(ctx, retry.Config, retry.TaskId)
+		if err != nil && err.ErrType() != domain.ErrTypeNotFound {
+			log.Println(err)
+			continue
+		}
+		// the task was deleted, timed out or reported on in the meantime
+		if err != nil || task.Status() != domain.PlacementTaskStatusAccepted {
+			s.dropRetry(ctx, retry)
+			continue
+		}
+		config, err := s.config(ctx, retry.Config)
+		if err != nil {
+			s.failDelivery(ctx, retry, err.Message())
+			continue
+		}
+		cmd, err := applyConfigCommand(config, retry.TaskId, retry.Strategy)
+		if err != nil {
+			s.failDelivery(ctx, retry, err.Message())
+			continue
+		}
+
+		retry.Attempts++
+		deseminateErr := s.deseminate(ctx, retry.Node, cmd, config.Type())
+		if deseminateErr != nil {
+			s.retryDelivery(ctx, retry, deseminateErr)
+			continue
+		}
+		log.Printf("delivered task %s to node %s after %d attempts", retry.TaskId, retry.Node, retry.Attempts)
+		if err := s.store.RecordDelivery(ctx, retry.Config, retry.TaskId, retry.Attempts, ""); err != nil {
+			log.Println(err)
+		}
+		s.dropRetry(ctx, retry)
+	}
+}
+
+// retryDelivery records a failed delivery attempt and schedules the next one,
+// or fails the task if it ran out of attempts. It reports whether the delivery
+// will be retried.
+func (s *PlacementService) retryDelivery(ctx context.Context, retry domain.DeliveryRetry, deliveryErr error) bool {
+	if err := s.store.RecordDelivery(ctx, retry.Config, retry.TaskId, retry.Attempts, deliveryErr.Error()); err != nil {
+		log.Println(err)
+	}
+	if retry.Attempts >= s.maxAttempts {
+		s.failDelivery(ctx, retry, deliveryErr.Error())
+		return false
+	}
+	retry.LastError = deliveryErr.Error()
+	retry.NextAttemptAt = time.Now().Add(retryBackoff(retry.Attempts)).Unix()
+	if err := s.retries.Put(ctx, retry); err != nil {
+		log.Println(err)
+	}
+	return true
+}
+
+func (s *PlacementService) failDelivery(ctx context.Context, retry domain.DeliveryRetry, reason string) {
+	log.Printf("giving up on delivering task %s to node %s: %s", retry.TaskId, retry.Node, reason)
+	if err := s.store.FailDelivery(ctx, retry.Config, retry.TaskId, reason); err != nil && err.ErrType() != domain.ErrTypeConflict {
+		log.Println(err)
+	}
+	s.dropRetry(ctx, retry)
+}
+
+func (s *PlacementService) dropRetry(ctx context.Context, retry domain.DeliveryRetry) {
+	if err := s.retries.Delete(ctx, retry.Config.Org, retry.TaskId); err != nil {
+		log.Println(err)
+	}
+}
+
+// retryBackoff doubles the delay with every attempt, randomizing its upper
+// half so that retries to a recovering agent queue are spread out.
+func retryBackoff(attempts int) time.Duration {
+	delay := retryMaxDelay
+	if attempts < 20 {
+		delay = min(retryBaseDelay<<(max(attempts, 1)-1), retryMaxDelay)
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		min      time.Duration
+		max      time.Duration
+	}{
+		{name: "no attempts yet", attempts: 0, min: time.Second, max: 2 * time.Second},
+		{name: "first attempt", attempts: 1, min: time.Second, max: 2 * time.Second},
+		{name: "doubles", attempts: 3, min: 4 * time.Second, max: 8 * time.Second},
+		{name: "capped", attempts: 10, min: retryMaxDelay / 2, max: retryMaxDelay},
+		{name: "shift doesn't overflow", attempts: 70, min: retryMaxDelay / 2, max: retryMaxDelay},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				delay := retryBackoff(tt.attempts)
+				if delay < tt.min || delay > tt.max {
+					t.Fatalf("retryBackoff(%d) = %s, want within [%s, %s]", tt.attempts, delay, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+// ParamSetSource is one side of a diff. Exactly one of Standalone, Group or
+// Inline is set; ParamSet names the param set to take from Group.
+type ParamSetSource struct {
+	Standalone *domain.ConfigRef
+	Group      *domain.ConfigRef
+	ParamSet   string
+	Inline     *domain.NamedParamSet
+}
+
+type DiffService struct {
+	standalone *StandaloneConfigService
+	groups     *ConfigGroupService
+}
+
+func NewDiffService(standalone *StandaloneConfigService, groups *ConfigGroupService) *DiffService {
+	return &DiffService{
+		standalone: standalone,
+		groups:     groups,
+	}
+}
+
+// Diff compares two param sets regardless of where they come from, e.g. a
+// standalone config with a param set of a group or a stored version with a
+// candidate that hasn't been saved yet.
+func (s *DiffService) Diff(ctx context.Context, reference, diff ParamSetSource, format domain.PatchFormat) ([]domain.Diff, string, *domain.Error) {
+	referenceName, referenceParams, err := s.resolve(ctx, reference)
+	if err != nil {
+		return nil, "", err
+	}
+	diffName, diffParams, err := s.resolve(ctx, diff)
+	if err != nil {
+		return nil, "", err
+	}
+	patch, err := paramSetPatch(referenceName, referenceParams, diffName, diffParams, format)
+	if err != nil {
+		return nil, "", err
+	}
+	return diffParams.Diff(referenceParams), patch, nil
+}
+
+func (s *DiffService) resolve(ctx context.Context, source ParamSetSource) (string, domain.NamedParamSet, *domain.Error) {
+	switch {
+	case source.Standalone != nil:
+		ref := source.Standalone
+		config, err := s.standalone.Get(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+		if err != nil {
+			return "", domain.NamedParamSet{}, err
+		}
+		return configLabel(config), *domain.NewParamSet(config.Name(), config.ParamSet()), nil
+	case source.Group != nil:
+		ref := source.Group
+		config, err := s.groups.Get(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+		if err != nil {
+			return "", domain.NamedParamSet{}, err
+		}
+		paramSet, err := config.ParamSet(source.ParamSet)
+		if err != nil {
+			return "", domain.NamedParamSet{}, err
+		}
+		return fmt.Sprintf("%s#%s", configLabel(config), paramSet.Name()), paramSet, nil
+	case source.Inline != nil:
+		return fmt.Sprintf("inline/%s", source.Inline.Name()), *source.Inline, nil
+	default:
+		return "", domain.NamedParamSet{}, domain.NewError(domain.ErrTypeSchemaInvalid, "diff side is empty")
+	}
+}
+
+// configDiffs compares two versions of the same config, keyed by param set
+// name. A nil reference is treated as an empty config.
+func configDiffs(config, reference domain.Config) map[string][]domain.Diff {
+	switch config := config.(type) {
+	case *domain.StandaloneConfig:
+		ref, ok := reference.(*domain.StandaloneConfig)
+		if !ok {
+			ref = domain.NewStandaloneConfig(config.Org(), config.Namespace(), "", *domain.NewParamSet(config.Name(), nil))
+		}
+		return map[string][]domain.Diff{config.Name(): config.Diff(ref)}
+	case *domain.ConfigGroup:
+		ref, ok := reference.(*domain.ConfigGroup)
+		if !ok {
+			ref = domain.NewConfigGroup(config.Org(), config.Namespace(), config.Name(), "", nil)
+		}
+		return config.Diff(ref)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+type DriftService struct {
+	authorizer  *AuthZService
+	placements  domain.PlacementStore
+	applied     domain.AppliedConfigStore
+	standalones domain.StandaloneConfigStore
+	groups      domain.ConfigGroupStore
+}
+
+func NewDriftService(authorizer *AuthZService, placements domain.PlacementStore, applied domain.AppliedConfigStore, standalones domain.StandaloneConfigStore, groups domain.ConfigGroupStore) *DriftService {
+	return &DriftService{
+		authorizer:  authorizer,
+		placements:  placements,
+		applied:     applied,
+		standalones: standalones,
+		groups:      groups,
+	}
+}
+
+// Report stores the configs a node currently runs, replacing its previous
+// report. Reports aren't authenticated, so only configs placed on the node by
+// one of the org's tasks are kept.
+func (s *DriftService) Report(ctx context.Context, org domain.Org, node domain.Node, applied []domain.AppliedConfig) *domain.Error {
+	placements, err := s.placements.ListByNode(ctx, org, node)
+	if err != nil {
+		return err
+	}
+	if len(placements) == 0 {
+		return domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("node %s has no placements in org %s", node, org))
+	}
+	placedByTask := make(map[string]domain.ConfigRef, len(placements))
+	for _, placement := range placements {
+		placedByTask[placement.Task.Id()] = placement.Config
+	}
+	reportedAt := time.Now().Unix()
+	verified := make([]domain.AppliedConfig, 0, len(applied))
+	for _, a := range applied {
+		a.Node = node
+		a.Config.Org = org
+		a.ReportedAt = reportedAt
+		if placed, ok := placedByTask[a.TaskId]; !ok || placed != a.Config {
+			log.Printf("skipping applied config %s/%s@%s on node %s, it wasn't placed by task %s", a.Config.Namespace, a.Config.Name, a.Config.Version, node, a.TaskId)
+			continue
+		}
+		verified = append(verified, a)
+	}
+	return s.applied.ReplaceForNode(ctx, org, node, verified)
+}
+
+// RecordApplied stores a single config a node acknowledged applying, if the
+// acknowledged task was placed on that node.
+func (s *DriftService) RecordApplied(ctx context.Context, applied domain.AppliedConfig) *domain.Error {
+	task, err := s.placements.Get(ctx, applied.Config, applied.TaskId)
+	if err != nil {
+		return err
+	}
+	if task.Node() != applied.Node {
+		return domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("task %s wasn't placed on node %s", applied.TaskId, applied.Node))
+	}
+	applied.ReportedAt = time.Now().Unix()
+	return s.applied.Put(ctx, applied)
+}
+
+// List compares the latest placed version of every config on every node with
+// the last state the node reported and returns the ones that don't match.
+func (s *DriftService) List(ctx context.Context, org domain.Org, namespace string, node domain.Node) ([]domain.ConfigDrift, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(org)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	var placements []domain.NodePlacement
+	var err *domain.Error
+	if node != "" {
+		placements, err = s.placements.ListByNode(ctx, org, node)
+	} else {
+		placements, err = s.placements.ListByOrg(ctx, org)
+	}
+	if err != nil {
+		return nil, err
+	}
+	applied, err := s.applied.ListByOrg(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	appliedByKey := make(map[string]domain.AppliedConfig, len(applied))
+	for _, a := range applied {
+		appliedByKey[appliedKey(a.Node, a.Config)] = a
+	}
+
+	expected := latestPlacedPerNode(placements)
+	configs := make(map[domain.ConfigRef]domain.Config)
+	drifts := make([]domain.ConfigDrift, 0)
+	for _, placement := range expected {
+		if namespace != "" && placement.Config.Namespace != namespace {
+			continue
+		}
+		expectedConfig, err := s.config(ctx, configs, placement.Config)
+		if err != nil {
+			// the config version might have been deleted since it was placed
+			log.Println(err)
+			continue
+		}
+		expectedHash := contentHash(expectedConfig)
+		drift := domain.ConfigDrift{
+			Node:         placement.Task.Node(),
+			Expected:     placement.Config,
+			ExpectedHash: expectedHash,
+		}
+		a, ok := appliedByKey[appliedKey(placement.Task.Node(), placement.Config)]
+		if ok {
+			if a.ContentHash == expectedHash {
+				continue
+			}
+			drift.Applied = &a
+			drift.Diffs = s.diffs(ctx, configs, expectedConfig, a)
+		}
+		drifts = append(drifts, drift)
+	}
+	return drifts, nil
+}
+
+// diffs is only able to tell what changed when the node runs content of
+// a version kuiper knows about.
+func (s *DriftService) diffs(ctx context.Context, configs map[domain.ConfigRef]domain.Config, expected domain.Config, applied domain.AppliedConfig) map[string][]domain.Diff {
+	if applied.Config.Version == "" {
+		return nil
+	}
+	appliedConfig, err := s.config(ctx, configs, applied.Config)
+	if err != nil || contentHash(appliedConfig) != applied.ContentHash {
+		return nil
+	}
+	return configDiffs(appliedConfig, expected)
+}
+
+func (s *DriftService) config(ctx context.Context, configs map[domain.ConfigRef]domain.Config, ref domain.ConfigRef) (domain.Config, *domain.Error) {
+	if config, ok := configs[ref]; ok {
+		return config, nil
+	}
+	var config domain.Config
+	switch ref.Type {
+	case domain.ConfTypeStandalone:
+		standalone, err := s.standalones.Get(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+		if err != nil {
+			return nil, err
+		}
+		config = standalone
+	case domain.ConfTypeGroup:
+		group, err := s.groups.Get(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+		if err != nil {
+			return nil, err
+		}
+		config = group
+	default:
+		return nil, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("Unknown config type: %s", ref.Type))
+	}
+	configs[ref] = config
+	return config, nil
+}
+
+func contentHash(config domain.Config) string {
+	switch config := config.(type) {
+	case *domain.StandaloneConfig:
+		return config.ContentHash()
+	case *domain.ConfigGroup:
+		return config.ContentHash()
+	}
+	return ""
+}
+
+func latestPlacedPerNode(placements []domain.NodePlacement) []domain.NodePlacement {
+	latestByKey := make(map[string]domain.NodePlacement)
+	for _, placement := range placements {
+		if placement.Task.Status() != domain.PlacementTaskStatusPlaced {
+			continue
+		}
+		key := appliedKey(placement.Task.Node(), placement.Config)
+		latest, ok := latestByKey[key]
+		if !ok || placement.Task.Sequence() > latest.Task.Sequence() {
+			latestByKey[key] = placement
+		}
+	}
+	keys := make([]string, 0, len(latestByKey))
+	for key := range latestByKey {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	latest := make([]domain.NodePlacement, 0, len(keys))
+	for _, key := range keys {
+		latest = append(latest, latestByKey[key])
+	}
+	return latest
+}
+
+func appliedKey(node domain.Node, config domain.ConfigRef) string {
+	return fmt.Sprintf("%s/%s/%s/%s", node, config.Type, config.Namespace, config.Name)
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+func placement(id string, node domain.Node, name, version string, status domain.PlacementTaskStatus, sequence int64) domain.NodePlacement {
+	task := domain.NewPlacementTask(id, node, status, 0, 0)
+	task.SetSequence(sequence)
+	return domain.NodePlacement{
+		Config: domain.ConfigRef{Org: "org", Namespace: "default", Name: name, Version: version, Type: domain.ConfTypeStandalone},
+		Task:   *task,
+	}
+}
+
+func TestLatestPlacedPerNode(t *testing.T) {
+	tests := []struct {
+		name       string
+		placements []domain.NodePlacement
+		want       []string
+	}{
+		{
+			name:       "nothing placed",
+			placements: []domain.NodePlacement{placement("t1", "n1", "db", "v1", domain.PlacementTaskStatusAccepted, 1)},
+			want:       []string{},
+		},
+		{
+			name: "higher sequence wins regardless of order",
+			placements: []domain.NodePlacement{
+				placement("t2", "n1", "db", "v2", domain.PlacementTaskStatusPlaced, 2),
+				placement("t1", "n1", "db", "v1", domain.PlacementTaskStatusPlaced, 1),
+			},
+			want: []string{"t2"},
+		},
+		{
+			name: "unresolved newer task doesn't hide the placed one",
+			placements: []domain.NodePlacement{
+				placement("t1", "n1", "db", "v1", domain.PlacementTaskStatusPlaced, 1),
+				placement("t2", "n1", "db", "v2", domain.PlacementTaskStatusFailed, 2),
+				placement("t3", "n1", "db", "v3", domain.PlacementTaskStatusAccepted, 3),
+			},
+			want: []string{"t1"},
+		},
+		{
+			name: "superseded and removed tasks aren't expected anymore",
+			placements: []domain.NodePlacement{
+				placement("t1", "n1", "db", "v1", domain.PlacementTaskStatusSuperseded, 1),
+				placement("t2", "n2", "db", "v1", domain.PlacementTaskStatusRemoved, 2),
+			},
+			want: []string{},
+		},
+		{
+			name: "one per node and config",
+			placements: []domain.NodePlacement{
+				placement("t3", "n2", "db", "v1", domain.PlacementTaskStatusPlaced, 3),
+				placement("t2", "n1", "cache", "v1", domain.PlacementTaskStatusPlaced, 2),
+				placement("t1", "n1", "db", "v1", domain.PlacementTaskStatusPlaced, 1),
+			},
+			want: []string{"t2", "t1", "t3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := make([]string, 0)
+			for _, placement := range latestPlacedPerNode(tt.placements) {
+				ids = append(ids, placement.Task.Id())
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("latest = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+type MaintenanceService struct {
+	authorizer *AuthZService
+	store      domain.MaintenanceWindowStore
+}
+
+func NewMaintenanceService(authorizer *AuthZService, store domain.MaintenanceWindowStore) *MaintenanceService {
+	return &MaintenanceService{
+		authorizer: authorizer,
+		store:      store,
+	}
+}
+
+func (s *MaintenanceService) Put(ctx context.Context, window domain.MaintenanceWindow) (*domain.MaintenanceWindow, *domain.Error) {
+	if err := s.authorizeWindow(ctx, window.Org, window.Namespace); err != nil {
+		return nil, err
+	}
+	if _, err := domain.ParseCronSchedule(window.Schedule); err != nil {
+		return nil, domain.NewError(domain.ErrTypeSchemaInvalid, err.Error())
+	}
+	if window.Duration < time.Minute || window.Duration > domain.MaxMaintenanceWindowDuration {
+		return nil, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("window duration must be between 1m and %s", domain.MaxMaintenanceWindowDuration))
+	}
+	window.CreatedAt = time.Now().Unix()
+	if err := s.store.Put(ctx, window); err != nil {
+		return nil, err
+	}
+	return &window, nil
+}
+
+func (s *MaintenanceService) Delete(ctx context.Context, org domain.Org, namespace, name string) (*domain.MaintenanceWindow, *domain.Error) {
+	if err := s.authorizeWindow(ctx, org, namespace); err != nil {
+		return nil, err
+	}
+	return s.store.Delete(ctx, org, namespace, name)
+}
+
+// List returns the windows that apply to the namespace, or all windows of the
+// org if namespace is empty.
+func (s *MaintenanceService) List(ctx context.Context, org domain.Org, namespace string) ([]domain.MaintenanceWindow, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(org)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	windows, err := s.store.ListByOrg(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]domain.MaintenanceWindow, 0, len(windows))
+	for _, window := range windows {
+		if namespace == "" || window.Applies(namespace) {
+			filtered = append(filtered, window)
+		}
+	}
+	slices.SortFunc(filtered, func(a, b domain.MaintenanceWindow) int {
+		if a.Namespace != b.Namespace {
+			return strings.Compare(a.Namespace, b.Namespace)
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return filtered, nil
+}
+
+// org-wide windows restrict every namespace, so managing them needs the
+// permission on the org itself
+func (s *MaintenanceService) authorizeWindow(ctx context.Context, org domain.Org, namespace string) *domain.Error {
+	kind, id := OortResNamespace, fmt.Sprintf("%s/%s", org, namespace)
+	if namespace == "" {
+		kind, id = OortResOrg, string(org)
+	}
+	if !s.authorizer.Authorize(ctx, PermNsPut, kind, id) {
+		return domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermNsPut))
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package services
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// paginate expects items sorted by key and returns the page following
+// pageToken together with the token of the next page, if there is one.
+func paginate[T any](items []T, key func(T) string, pageSize int32, pageToken string) ([]T, string) {
+	size := pageLimit(pageSize)
+	start := 0
+	if pageToken != "" {
+		for start < len(items) && key(items[start]) <= pageToken {
+			start++
+		}
+	}
+	end := start + size
+	if end >= len(items) {
+		return items[start:], ""
+	}
+	return items[start:end], key(items[end-1])
+}
+
+// pageLimit is the number of items a page of the requested size holds.
+func pageLimit(pageSize int32) int {
+	size := int(pageSize)
+	if size <= 0 {
+		return defaultPageSize
+	}
+	return min(size, maxPageSize)
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+func paramSetPatch(referenceName string, reference domain.NamedParamSet, diffName string, diff domain.NamedParamSet, format domain.PatchFormat) (string, *domain.Error) {
+	var patch []byte
+	var err error
+	switch format {
+	case domain.PatchFormatNone:
+		return "", nil
+	case domain.PatchFormatJSONPatch:
+		patch, err = domain.JSONPatch(diff.Diff(reference))
+	case domain.PatchFormatMergePatch:
+		patch, err = domain.MergePatch(diff.Diff(reference))
+	case domain.PatchFormatUnified:
+		return unifiedPatch(referenceName, []domain.NamedParamSet{reference}, diffName, []domain.NamedParamSet{diff})
+	default:
+		return "", domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("unknown diff format: %d", format))
+	}
+	if err != nil {
+		return "", domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	return string(patch), nil
+}
+
+func groupPatch(reference, diff *domain.ConfigGroup, format domain.PatchFormat) (string, *domain.Error) {
+	var patch []byte
+	var err error
+	switch format {
+	case domain.PatchFormatNone:
+		return "", nil
+	case domain.PatchFormatJSONPatch:
+		patch, err = diff.JSONPatch(reference)
+	case domain.PatchFormatMergePatch:
+		patch, err = diff.MergePatch(reference)
+	case domain.PatchFormatUnified:
+		return unifiedPatch(configLabel(reference), reference.ParamSets(), configLabel(diff), diff.ParamSets())
+	default:
+		return "", domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("unknown diff format: %d", format))
+	}
+	if err != nil {
+		return "", domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	return string(patch), nil
+}
+
+func unifiedPatch(referenceName string, reference []domain.NamedParamSet, diffName string, diff []domain.NamedParamSet) (string, *domain.Error) {
+	referenceYaml, err := renderParamSets(reference)
+	if err != nil {
+		return "", err
+	}
+	diffYaml, err := renderParamSets(diff)
+	if err != nil {
+		return "", err
+	}
+	return domain.UnifiedDiff(referenceName, diffName, referenceYaml, diffYaml), nil
+}
+
+func renderParamSets(paramSets []domain.NamedParamSet) (string, *domain.Error) {
+	configMap := make(map[string]map[string]string)
+	for _, paramSet := range paramSets {
+		configMap[paramSet.Name()] = paramSet.ParamSet()
+	}
+	yamlBytes, err := yaml.Marshal(configMap)
+	if err != nil {
+		return "", domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	return string(yamlBytes), nil
+}
+
+func configLabel(config domain.Config) string {
+	return fmt.Sprintf("%s/%s/%s/%s", config.Org(), config.Namespace(), config.Name(), config.Version())
+}
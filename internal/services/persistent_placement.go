@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/pkg/api"
+	"google.golang.org/protobuf/proto"
+)
+
+// Persist stores the strategy as the desired state of the config, so that
+// ReconcilePersistent keeps placing it on the nodes the strategy selects.
+func (s *PlacementService) Persist(ctx context.Context, ref domain.ConfigRef, strategy *api.PlaceReq_Strategy, timeout time.Duration, removeUnmatched bool) *domain.Error {
+	config, err := s.config(ctx, ref)
+	if err != nil {
+		return err
+	}
+	if err := s.authorizePlace(ctx, config); err != nil {
+		return err
+	}
+	// the reconciler has no credentials of its own, it places the config as
+	// whoever made the placement persistent
+	identity, err := s.authorizer.Identity(ctx, config.Org())
+	if err != nil {
+		return err
+	}
+	return s.persist(ctx, config, strategy, timeout, removeUnmatched, identity)
+}
+
+func (s *PlacementService) persist(ctx context.Context, config domain.Config, strategy *api.PlaceReq_Strategy, timeout time.Duration, removeUnmatched bool, identity domain.ServiceIdentity) *domain.Error {
+	placementStrategy, ok := s.strategies.Get(strategy.Name)
+	if !ok {
+		return domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("Unknown strategy: %s", strategy.Name))
+	}
+	if err := placementStrategy.Validate(strategy); err != nil {
+		return err
+	}
+	marshalled, err := proto.Marshal(strategy)
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	return s.persistent.Put(ctx, domain.PersistentPlacement{
+		Config:          domain.NewConfigRef(config),
+		Strategy:        marshalled,
+		Timeout:         timeout,
+		RemoveUnmatched: removeUnmatched,
+		CreatedAt:       time.Now().Unix(),
+		Identity:        identity,
+	})
+}
+
+// replacePersistent drops the config's persistent placement if it places
+// another version, which it would otherwise keep placing on new nodes.
+func (s *PlacementService) replacePersistent(ctx context.Context, config domain.Config) *domain.Error {
+	replaced, err := s.persistent.DeleteOtherVersion(ctx, domain.NewConfigRef(config))
+	if err != nil {
+		return err
+	}
+	if replaced != nil {
+		log.Printf("placing version %s of %s/%s replaced its persistent placement of version %s", config.Version(), config.Namespace(), config.Name(), replaced.Config.Version)
+	}
+	return nil
+}
+
+func (s *PlacementService) ListPersistent(ctx context.Context, org domain.Org, namespace string) ([]domain.PersistentPlacement, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(org)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	placements, err := s.persistent.ListByOrg(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(placements, func(placement domain.PersistentPlacement) bool {
+		return namespace != "" && placement.Config.Namespace != namespace
+	}), nil
+}
+
+// DeletePersistent stops reconciling the config's placement, the config
+// stays on the nodes it was placed on.
+func (s *PlacementService) DeletePersistent(ctx context.Context, ref domain.ConfigRef) (*domain.PersistentPlacement, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermNsPut, OortResNamespace, fmt.Sprintf("%s/%s", ref.Org, ref.Namespace)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermNsPut))
+	}
+	return s.persistent.Delete(ctx, ref)
+}
+
+// ReconcilePersistent places every persistent config on the nodes its
+// strategy selects that haven't received it yet and, if asked to, removes it
+// from the nodes the strategy no longer selects. It is meant to be run
+// periodically by a single Kuiper instance.
+func (s *PlacementService) ReconcilePersistent(ctx context.Context) {
+	placements, err := s.persistent.List(ctx)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, placement := range placements {
+		if err := s.reconcilePersistent(ctx, placement); err != nil {
+			log.Printf("reconciling persistent placement of %s/%s: %s", placement.Config.Namespace, placement.Config.Name, err.Message())
+		}
+	}
+}
+
+func (s *PlacementService) reconcilePersistent(ctx context.Context, placement domain.PersistentPlacement) *domain.Error {
+	authorizedCtx, err := s.authorizer.Impersonate(ctx, placement.Identity)
+	if err != nil {
+		return s.dropPersistent(ctx, placement, err)
+	}
+	ctx = authorizedCtx
+	config, err := s.config(ctx, placement.Config)
+	if err != nil {
+		if err.ErrType() == domain.ErrTypeNotFound {
+			// the config was deleted, there's nothing left to place
+			_, err = s.persistent.Delete(ctx, placement.Config)
+		}
+		return err
+	}
+	// whoever made the placement persistent may have lost access since
+	if err := s.authorizePlace(ctx, config); err != nil {
+		return s.dropPersistent(ctx, placement, err)
+	}
+	windows, err := s.windows.ListByOrg(ctx, placement.Config.Org)
+	if err != nil {
+		return err
+	}
+	// new nodes are picked up once a window opens
+	if !domain.PlacementAllowed(windows, placement.Config.Namespace, time.Now()) {
+		return nil
+	}
+	strategy := &api.PlaceReq_Strategy{}
+	if err := proto.Unmarshal(placement.Strategy, strategy); err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	nodes, _, err := s.selectNodes(ctx, config, strategy)
+	if err != nil {
+		return err
+	}
+	tasks, err := s.store.ListByConfig(ctx, config.Org(), config.Namespace(), config.Name(), config.Version(), config.Type())
+	if err != nil {
+		return err
+	}
+
+	// nodes the config was removed from get it again once they match, the
+	// others are never retried, even if their task failed
+	targeted := make(map[domain.Node]bool)
+	for _, task := range tasks {
+		if task.Status() != domain.PlacementTaskStatusRemoved {
+			targeted[task.Node()] = true
+		}
+	}
+	matched := make(map[domain.Node]bool)
+	pending := make([]domain.Node, 0)
+	for _, node := range nodes {
+		matched[node] = true
+		if !targeted[node] {
+			pending = append(pending, node)
+		}
+	}
+	if len(pending) > 0 {
+		log.Printf("placing persistent config %s/%s on %d new nodes", config.Namespace(), config.Name(), len(pending))
+		s.dispatch(ctx, config, newPlacementTasks(pending, s.taskTimeout(placement.Timeout), strategy.Seed), strategy.Name)
+	}
+	if placement.RemoveUnmatched {
+		return s.removeUnmatched(ctx, config, tasks, matched)
+	}
+	return nil
+}
+
+// dropPersistent stops reconciling a placement its identity may no longer
+// make, unless it was replaced with another version in the meantime.
+func (s *PlacementService) dropPersistent(ctx context.Context, placement domain.PersistentPlacement, reason *domain.Error) *domain.Error {
+	if _, err := s.persistent.DeleteVersion(ctx, placement.Config); err != nil {
+		return err
+	}
+	return domain.NewError(reason.ErrType(), fmt.Sprintf("dropped: %s", reason.Message()))
+}
+
+// removalResendInterval is how long the reconciler waits for an agent to
+// acknowledge a removal before sending it again.
+const removalResendInterval = 10 * time.Minute
+
+// removeUnmatched sends a removal to the nodes that run the config's version
+// but aren't selected by the strategy anymore. The tasks are marked as removed
+// once the agents acknowledge it.
+func (s *PlacementService) removeUnmatched(ctx context.Context, config domain.Config, tasks []domain.PlacementTask, matched map[domain.Node]bool) *domain.Error {
+	ref := domain.NewConfigRef(config)
+	latestByNode := make(map[domain.Node]domain.PlacementTask)
+	for _, task := range tasks {
+		if task.Status() != domain.PlacementTaskStatusPlaced || matched[task.Node()] {
+			continue
+		}
+		if latest, ok := latestByNode[task.Node()]; !ok || task.Sequence() > latest.Sequence() {
+			latestByNode[task.Node()] = task
+		}
+	}
+	now := time.Now()
+	for node, task := range latestByNode {
+		if now.Sub(time.Unix(task.RemovalRequestedAt(), 0)) < removalResendInterval {
+			continue
+		}
+		current, err := s.store.ListByNode(ctx, ref.Org, node)
+		if err != nil {
+			return err
+		}
+		// nodes that got another version since were placed on by someone else
+		if !slices.ContainsFunc(latestPlacedPerNode(current), func(placement domain.NodePlacement) bool {
+			return placement.Task.Id() == task.Id()
+		}) {
+			continue
+		}
+		cmd, err := removeConfigCommand(config, task.Id())
+		if err != nil {
+			return err
+		}
+		if err := s.deseminate(ctx, node, cmd, config.Type()); err != nil {
+			log.Println(err)
+			continue
+		}
+		log.Printf("sent removal of persistent config %s/%s to node %s", config.Namespace(), config.Name(), node)
+		if err := s.store.RequestRemoval(ctx, ref, task.Id()); err != nil {
+			log.Println(err)
+		}
+	}
+	return nil
+}
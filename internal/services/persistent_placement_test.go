@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+func TestReconcilePersistentAuthorization(t *testing.T) {
+	// only opens on new year's day, so authorized placements stop before
+	// selecting nodes
+	closed := domain.MaintenanceWindow{Org: "org", Namespace: "ns", Name: "yearly", Schedule: "0 0 1 1 *", Duration: time.Minute}
+	tests := []struct {
+		name     string
+		identity domain.ServiceIdentity
+		dropped  bool
+	}{
+		{
+			name:     "token expired",
+			identity: domain.ServiceIdentity{Org: "org", Permissions: placePermissions, ExpiresAt: time.Now().Add(-time.Minute).Unix()},
+			dropped:  true,
+		},
+		{
+			name:     "access revoked",
+			identity: domain.ServiceIdentity{Org: "org", Permissions: placePermissions[1:]},
+			dropped:  true,
+		},
+		{
+			name:     "authorized",
+			identity: domain.ServiceIdentity{Org: "org", Permissions: placePermissions, ExpiresAt: time.Now().Add(time.Hour).Unix()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestPlacementService(newFakePlacementStore(), &fakeAgentQueue{}, &fakeRetryStore{}, 1)
+			s.windows = fakeWindowStore{windows: []domain.MaintenanceWindow{closed}}
+			persistent := &fakePersistentStore{}
+			s.persistent = persistent
+
+			err := s.reconcilePersistent(context.Background(), domain.PersistentPlacement{Config: testConfigRef, Identity: tt.identity})
+			if persistent.deleted != tt.dropped {
+				t.Errorf("dropped = %t, want %t", persistent.deleted, tt.dropped)
+			}
+			if tt.dropped && (err == nil || err.ErrType() != domain.ErrTypeUnauthorized) {
+				t.Errorf("err = %v, want unauthorized", err)
+			}
+			if !tt.dropped && err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -4,154 +4,525 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"math"
-	"math/rand"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/c12s/kuiper/internal/domain"
 	"github.com/c12s/kuiper/pkg/api"
 	"github.com/c12s/kuiper/pkg/client/agent_queue"
 	magnetarapi "github.com/c12s/magnetar/pkg/api"
+	"github.com/c12s/magnetar/pkg/messaging"
 	oortapi "github.com/c12s/oort/pkg/api"
 	"github.com/google/uuid"
-	"google.golang.org/grpc/metadata"
 )
 
 type PlacementService struct {
-	magnetar       magnetarapi.MagnetarClient
-	aq             agent_queue.AgentQueueClient
-	administrator  *oortapi.AdministrationAsyncClient
-	authorizer     *AuthZService
-	store          domain.PlacementStore
-	webhookBaseUrl string
+	strategies      *PlacementStrategyRegistry
+	aq              agent_queue.AgentQueueClient
+	administrator   *oortapi.AdministrationAsyncClient
+	authorizer      *AuthZService
+	store           domain.PlacementStore
+	standalones     domain.StandaloneConfigStore
+	groups          domain.ConfigGroupStore
+	webhookBaseUrl  string
+	timeout         time.Duration
+	publisher       messaging.Publisher
+	retries         domain.DeliveryRetryStore
+	maxAttempts     int
+	windows         domain.MaintenanceWindowStore
+	persistent      domain.PersistentPlacementStore
+	rollouts        domain.RolloutStore
+	schedules       domain.ScheduledPlacementStore
+	workers         int
+	deliveryTimeout time.Duration
+	// async deliveries that are still running
+	deliveries sync.WaitGroup
+	stopMu     sync.Mutex
+	stopping   chan struct{}
 }
 
-func NewPlacementStore(magnetar magnetarapi.MagnetarClient, aq agent_queue.AgentQueueClient, administrator *oortapi.AdministrationAsyncClient, authorizer *AuthZService, store domain.PlacementStore, webhookBaseUrl string) *PlacementService {
+func NewPlacementStore(strategies *PlacementStrategyRegistry, aq agent_queue.AgentQueueClient, administrator *oortapi.AdministrationAsyncClient, authorizer *AuthZService, store domain.PlacementStore, standalones domain.StandaloneConfigStore, groups domain.ConfigGroupStore, webhookBaseUrl string, timeout time.Duration, publisher messaging.Publisher, retries domain.DeliveryRetryStore, maxAttempts int, windows domain.MaintenanceWindowStore, persistent domain.PersistentPlacementStore, rollouts domain.RolloutStore, schedules domain.ScheduledPlacementStore, workers int, deliveryTimeout time.Duration) *PlacementService {
 	return &PlacementService{
-		magnetar:       magnetar,
-		aq:             aq,
-		administrator:  administrator,
-		authorizer:     authorizer,
-		store:          store,
-		webhookBaseUrl: webhookBaseUrl,
+		strategies:      strategies,
+		aq:              aq,
+		administrator:   administrator,
+		authorizer:      authorizer,
+		store:           store,
+		standalones:     standalones,
+		groups:          groups,
+		webhookBaseUrl:  webhookBaseUrl,
+		timeout:         timeout,
+		publisher:       publisher,
+		retries:         retries,
+		maxAttempts:     maxAttempts,
+		windows:         windows,
+		persistent:      persistent,
+		rollouts:        rollouts,
+		schedules:       schedules,
+		workers:         workers,
+		deliveryTimeout: deliveryTimeout,
+		stopping:        make(chan struct{}),
 	}
 }
 
-func (s *PlacementService) Place(ctx context.Context, config domain.Config, strategy *api.PlaceReq_Strategy, cmd func(taskId string) ([]byte, *domain.Error), webhookPath string) ([]domain.PlacementTask, *domain.Error) {
+// Place selects the nodes and dispatches a task to each of them. An async
+// placement returns as soon as the tasks are stored and keeps delivering
+// them after the request is done.
+func (s *PlacementService) Place(ctx context.Context, config domain.Config, strategy *api.PlaceReq_Strategy, timeout time.Duration, async bool) (string, []domain.PlacementTask, []domain.ConstraintViolation, *domain.Error) {
+	if err := s.authorizePlace(ctx, config); err != nil {
+		return "", nil, nil, err
+	}
+	if err := s.checkWindow(ctx, domain.NewConfigRef(config), time.Now()); err != nil {
+		return "", nil, nil, err
+	}
+	return s.place(ctx, config, strategy, timeout, async)
+}
+
+// place selects the nodes and dispatches the tasks without any checks, the
+// caller is expected to have authorized the placement.
+func (s *PlacementService) place(ctx context.Context, config domain.Config, strategy *api.PlaceReq_Strategy, timeout time.Duration, async bool) (string, []domain.PlacementTask, []domain.ConstraintViolation, *domain.Error) {
+	nodes, violations, err := s.selectNodes(ctx, config, strategy)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if err := s.replacePersistent(ctx, config); err != nil {
+		return "", nil, nil, err
+	}
+	placementId := uuid.New().String()
+	tasks := newPlacementTasks(nodes, s.taskTimeout(timeout), strategy.Seed)
+	for _, task := range tasks {
+		task.SetPlacementId(placementId)
+	}
+	if !async {
+		return placementId, s.dispatch(ctx, config, tasks, strategy.Name), violations, nil
+	}
+	stored := s.storeTasks(ctx, config, tasks)
+	accepted := make([]domain.PlacementTask, 0, len(stored))
+	for _, task := range stored {
+		accepted = append(accepted, *task)
+	}
+	// the fan-out outlives the request, but keeps its values such as the token
+	s.deliverAsync(context.WithoutCancel(ctx), config, stored, strategy.Name)
+	return placementId, accepted, violations, nil
+}
+
+func (s *PlacementService) authorizePlace(ctx context.Context, config domain.Config) *domain.Error {
 	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(config.Type(), string(config.Org()), config.Namespace(), config.Name(), config.Version())) {
-		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+		return domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
 	}
 	if !s.authorizer.Authorize(ctx, PermNsPut, OortResNamespace, fmt.Sprintf("%s/%s", config.Org(), config.Namespace())) {
-		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermNsPut))
+		return domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermNsPut))
+	}
+	return nil
+}
+
+// checkWindow rejects placements that would happen outside the maintenance
+// windows of the config's namespace, unless the caller may override them.
+func (s *PlacementService) checkWindow(ctx context.Context, ref domain.ConfigRef, at time.Time) *domain.Error {
+	windows, err := s.windows.ListByOrg(ctx, ref.Org)
+	if err != nil {
+		return err
+	}
+	if domain.PlacementAllowed(windows, ref.Namespace, at) {
+		return nil
+	}
+	if s.authorizer.Authorize(ctx, PermNsPlaceOutsideWindow, OortResNamespace, fmt.Sprintf("%s/%s", ref.Org, ref.Namespace)) {
+		return nil
 	}
+	return domain.NewError(domain.ErrTypeFailedPrecondition, fmt.Sprintf("no maintenance window of namespace %s is open at %s", ref.Namespace, at.UTC()))
+}
 
-	var nodes []*magnetarapi.NodeStringified
+func (s *PlacementService) selectNodes(ctx context.Context, config domain.Config, strategy *api.PlaceReq_Strategy) ([]domain.Node, []domain.ConstraintViolation, *domain.Error) {
+	selected, violations, err := s.runStrategy(ctx, config, strategy, mapConstraints(config.Org(), strategy.Constraints))
+	if err != nil {
+		return nil, nil, err
+	}
+	nodes := make([]domain.Node, 0, len(selected))
+	for _, node := range selected {
+		nodes = append(nodes, node.Node)
+	}
+	return nodes, violations, nil
+}
 
-	if strategy.Name == "default" {
-		if strategy.Query == nil {
-			return nil, domain.NewError(domain.ErrTypeSchemaInvalid, "Query is required for default strategy")
+// runStrategy selects the nodes with the strategy and narrows them down to
+// the ones satisfying the constraints.
+func (s *PlacementService) runStrategy(ctx context.Context, config domain.Config, strategy *api.PlaceReq_Strategy, constraints domain.PlacementConstraints) ([]domain.CandidateNode, []domain.ConstraintViolation, *domain.Error) {
+	placementStrategy, ok := s.strategies.Get(strategy.Name)
+	if !ok {
+		return nil, nil, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("Unknown strategy: %s", strategy.Name))
+	}
+	if err := placementStrategy.Validate(strategy); err != nil {
+		return nil, nil, err
+	}
+	selected, err := placementStrategy.SelectNodes(ctx, config, strategy)
+	if err != nil {
+		return nil, nil, err
+	}
+	candidates := make([]domain.CandidateNode, 0, len(selected))
+	for _, node := range selected {
+		candidates = append(candidates, mapCandidateNode(node))
+	}
+	if constraints.Empty() {
+		return candidates, nil, nil
+	}
+	var placed map[domain.Node][]domain.ConfigRef
+	if len(constraints.AntiAffinity) > 0 {
+		placed, err = s.placedPerNode(ctx, config.Org())
+		if err != nil {
+			return nil, nil, err
 		}
-		nodes, _ = s.placeByQuery(ctx, config, strategy.Query)
-	} else if strategy.Name == "gossip" {
-		if strategy.Percentage == 0 {
-			return nil, domain.NewError(domain.ErrTypeSchemaInvalid, "Percentage can't be 0 for gossip strategy")
+	}
+	candidates, violations := constraints.Apply(candidates, placed)
+	return candidates, violations, nil
+}
+
+// placedPerNode returns the configs whose latest task on a node is placed.
+func (s *PlacementService) placedPerNode(ctx context.Context, org domain.Org) (map[domain.Node][]domain.ConfigRef, *domain.Error) {
+	placements, err := s.store.ListByOrg(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	placed := make(map[domain.Node][]domain.ConfigRef)
+	for _, placement := range latestPlacedPerNode(placements) {
+		placed[placement.Task.Node()] = append(placed[placement.Task.Node()], placement.Config)
+	}
+	return placed, nil
+}
+
+func mapCandidateNode(node *magnetarapi.NodeStringified) domain.CandidateNode {
+	candidate := domain.CandidateNode{
+		Node:   domain.Node(node.Id),
+		Labels: make(map[string]string, len(node.Labels)),
+	}
+	for _, label := range node.Labels {
+		candidate.Labels[label.Key] = label.Value
+	}
+	return candidate
+}
+
+func mapConstraints(org domain.Org, constraints *api.PlacementConstraints) domain.PlacementConstraints {
+	mapped := domain.PlacementConstraints{}
+	if constraints == nil {
+		return mapped
+	}
+	mapped.SpreadBy = constraints.SpreadBy
+	if len(constraints.MaxPerLabel) > 0 {
+		mapped.MaxPerLabel = make(map[string]int, len(constraints.MaxPerLabel))
+		for _, maxPerLabel := range constraints.MaxPerLabel {
+			mapped.MaxPerLabel[maxPerLabel.Label] = int(maxPerLabel.Max)
 		}
-		nodes, _ = s.placeByGossip(ctx, config, strategy.Percentage)
-	} else {
-		return nil, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("Unknown strategy: %s", strategy.Name))
 	}
+	for _, node := range constraints.ExcludeNodes {
+		mapped.ExcludeNodes = append(mapped.ExcludeNodes, domain.Node(node))
+	}
+	for _, ref := range constraints.AntiAffinity {
+		mapped.AntiAffinity = append(mapped.AntiAffinity, domain.ConfigRef{
+			Org:       org,
+			Type:      ref.Type,
+			Namespace: ref.Namespace,
+			Name:      ref.Name,
+		})
+	}
+	return mapped
+}
 
-	tasks := make([]domain.PlacementTask, 0)
+// taskTimeout falls back to the default timeout if the placement doesn't
+// set one.
+func (s *PlacementService) taskTimeout(timeout time.Duration) time.Duration {
+	if timeout > 0 {
+		return timeout
+	}
+	return s.timeout
+}
+
+func newPlacementTasks(nodes []domain.Node, timeout time.Duration, seed string) []*domain.PlacementTask {
+	tasks := make([]*domain.PlacementTask, 0, len(nodes))
 	for _, node := range nodes {
-		taskId := uuid.New().String()
 		acceptedTs := time.Now().Unix()
-		task := domain.NewPlacementTask(taskId, domain.Node(node.Id), domain.PlacementTaskStatusAccepted, acceptedTs, acceptedTs)
-		placeErr := s.store.Place(ctx, config, task)
-		if placeErr != nil {
-			log.Println(placeErr)
-			continue
+		task := domain.NewPlacementTask(uuid.New().String(), node, domain.PlacementTaskStatusAccepted, acceptedTs, acceptedTs)
+		task.SetSeed(seed)
+		if timeout > 0 {
+			task.SetDeadline(acceptedTs + int64(timeout.Seconds()))
 		}
-		tasks = append(tasks, *task)
-		cmdMarshalled, err := cmd(taskId)
-		if err != nil {
+		tasks = append(tasks, task)
+	}
+	return tasks
+}
+
+// dispatch stores the placement tasks and then sends the config to the
+// agents, handing up to s.workers of them to the agent queue at once. All
+// tasks are stored before the first delivery so that a cancellation made
+// meanwhile sees them. It doesn't authorize, so it's also used by background
+// placements.
+func (s *PlacementService) dispatch(ctx context.Context, config domain.Config, tasks []*domain.PlacementTask, strategyName string) []domain.PlacementTask {
+	stored := s.storeTasks(ctx, config, tasks)
+	s.deliver(ctx, config, stored, strategyName)
+	placed := make([]domain.PlacementTask, 0, len(stored))
+	for _, task := range stored {
+		placed = append(placed, *task)
+	}
+	return placed
+}
+
+// storeTasks returns the tasks that were stored.
+func (s *PlacementService) storeTasks(ctx context.Context, config domain.Config, tasks []*domain.PlacementTask) []*domain.PlacementTask {
+	stored := make([]bool, len(tasks))
+	s.forEach(len(tasks), func(i int) {
+		if err := s.store.Place(ctx, config, tasks[i]); err != nil {
 			log.Println(err)
-			continue
+			return
 		}
-		deseminateErr := deseminateConfig(ctx, node.Id, cmdMarshalled, s.aq, s.webhookBaseUrl+webhookPath)
-		if deseminateErr != nil {
-			log.Println(deseminateErr)
+		stored[i] = true
+	})
+	placed := make([]*domain.PlacementTask, 0, len(tasks))
+	for i, task := range tasks {
+		if stored[i] {
+			placed = append(placed, task)
 		}
 	}
-	return tasks, nil
+	return placed
 }
 
-func (s *PlacementService) placeByQuery(ctx context.Context, config domain.Config, nodeQuery []*magnetarapi.Selector) ([]*magnetarapi.NodeStringified, *domain.Error) {
-	queryReq := &magnetarapi.QueryOrgOwnedNodesReq{
-		Org: string(config.Org()),
+func (s *PlacementService) deliver(ctx context.Context, config domain.Config, tasks []*domain.PlacementTask, strategyName string) {
+	s.forEach(len(tasks), func(i int) {
+		s.deliverTask(ctx, config, tasks[i], strategyName)
+	})
+}
+
+// deliverAsync delivers the tasks in the background, unless deliveries are
+// being stopped, in which case they're handed to the retry queue right away.
+func (s *PlacementService) deliverAsync(ctx context.Context, config domain.Config, tasks []*domain.PlacementTask, strategyName string) {
+	s.stopMu.Lock()
+	defer s.stopMu.Unlock()
+	if s.deliveriesStopped() {
+		for _, task := range tasks {
+			s.handOver(ctx, config, task, strategyName)
+		}
+		return
 	}
-	query := make([]*magnetarapi.Selector, 0)
-	for _, selector := range nodeQuery {
-		s := copySelector(selector)
-		query = append(query, &s)
+	s.deliveries.Add(1)
+	go func() {
+		defer s.deliveries.Done()
+		s.deliver(ctx, config, tasks, strategyName)
+	}()
+}
+
+// StopDeliveries waits for the async deliveries to finish. The configs they
+// haven't sent yet are handed to the retry queue, so that whichever instance
+// runs it delivers them.
+func (s *PlacementService) StopDeliveries() {
+	s.stopMu.Lock()
+	if !s.deliveriesStopped() {
+		close(s.stopping)
 	}
-	queryReq.Query = query
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		log.Println("no metadata in ctx when sending req to magnetar")
-	} else {
-		ctx = metadata.NewOutgoingContext(ctx, md)
+	s.stopMu.Unlock()
+	s.deliveries.Wait()
+}
+
+func (s *PlacementService) deliveriesStopped() bool {
+	select {
+	case <-s.stopping:
+		return true
+	default:
+		return false
 	}
-	queryResp, err := s.magnetar.QueryOrgOwnedNodes(ctx, queryReq)
+}
+
+// handOver queues the task's next delivery attempt.
+func (s *PlacementService) handOver(ctx context.Context, config domain.Config, task *domain.PlacementTask, strategyName string) {
+	err := s.retries.Put(ctx, domain.DeliveryRetry{
+		Config:        domain.NewConfigRef(config),
+		TaskId:        task.Id(),
+		Node:          task.Node(),
+		Strategy:      strategyName,
+		Attempts:      task.Attempts(),
+		NextAttemptAt: time.Now().Unix(),
+	})
 	if err != nil {
-		return nil, domain.NewError(domain.ErrTypeInternal, err.Error())
+		log.Println(err)
 	}
-	return queryResp.Nodes, nil
 }
 
-func (s *PlacementService) placeByGossip(ctx context.Context, config domain.Config, percentage int32) ([]*magnetarapi.NodeStringified, *domain.Error) {
-	queryReq := &magnetarapi.ListOrgOwnedNodesReq{
-		Org: string(config.Org()),
+// forEach calls fn for 0..n-1, running up to s.workers calls at once.
+func (s *PlacementService) forEach(n int, fn func(i int)) {
+	workers := make(chan struct{}, max(s.workers, 1))
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		workers <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
 	}
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		log.Println("no metadata in ctx when sending req to magnetar")
-	} else {
-		ctx = metadata.NewOutgoingContext(ctx, md)
+	wg.Wait()
+}
+
+// deliverTask sends the config of a stored task to its node, a failed
+// delivery is retried in the background. The task is updated to what was
+// stored for it.
+func (s *PlacementService) deliverTask(ctx context.Context, config domain.Config, task *domain.PlacementTask, strategyName string) {
+	if s.deliveriesStopped() {
+		s.handOver(ctx, config, task, strategyName)
+		return
 	}
-	queryResp, err := s.magnetar.ListOrgOwnedNodes(ctx, queryReq)
+	ref := domain.NewConfigRef(config)
+	// the task may have been cancelled since it was stored
+	current, err := s.store.Get(ctx, ref, task.Id())
 	if err != nil {
-		return nil, domain.NewError(domain.ErrTypeInternal, err.Error())
+		// a task that's gone has nothing left to deliver, any other task is
+		// left to the retry queue rather than pending forever
+		if err.ErrType() != domain.ErrTypeNotFound {
+			log.Println(err)
+			s.handOver(ctx, config, task, strategyName)
+		}
+		return
+	}
+	if current.Status() != domain.PlacementTaskStatusAccepted {
+		*task = *current
+		return
+	}
+	retry := domain.DeliveryRetry{
+		Config:   ref,
+		TaskId:   task.Id(),
+		Node:     task.Node(),
+		Strategy: strategyName,
+		Attempts: task.Attempts(),
+	}
+	cmdMarshalled, err := applyConfigCommand(config, task.Id(), strategyName)
+	if err != nil {
+		log.Println(err)
+		s.failDelivery(ctx, retry, err.Message())
+		task.Resolve(domain.PlacementTaskStatusFailed, err.Message(), time.Now().Unix())
+		return
+	}
+	// an attempt counts once the config is handed to the agent queue, the
+	// retry queue counts its attempts the same way
+	retry.Attempts++
+	task.SetAttempts(retry.Attempts)
+	deseminateErr := s.deseminate(ctx, task.Node(), cmdMarshalled, config.Type())
+	if deseminateErr != nil {
+		log.Println(deseminateErr)
+		task.SetReason(deseminateErr.Error())
+		if !s.retryDelivery(ctx, retry, deseminateErr) {
+			task.Resolve(domain.PlacementTaskStatusFailed, deseminateErr.Error(), time.Now().Unix())
+		}
+		return
+	}
+	if err := s.store.RecordDelivery(ctx, ref, task.Id(), retry.Attempts, ""); err != nil {
+		log.Println(err)
 	}
+}
 
-	fmt.Printf("queryResp.Nodes: %+v\n", queryResp.Nodes)
+// deseminate hands the command to the agent queue, giving up after the
+// delivery timeout so a slow queue doesn't hold up the other deliveries.
+func (s *PlacementService) deseminate(ctx context.Context, node domain.Node, cmd []byte, configType string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.deliveryTimeout)
+	defer cancel()
+	return deseminateConfig(ctx, string(node), cmd, s.aq, s.webhookBaseUrl+webhookPath(configType))
+}
 
-	nodes := selectRandmNodes(queryResp.Nodes, percentage)
-	return nodes, nil
+// config loads a config version without authorization, for placements that
+// were already authorized when they were requested.
+func (s *PlacementService) config(ctx context.Context, ref domain.ConfigRef) (domain.Config, *domain.Error) {
+	switch ref.Type {
+	case domain.ConfTypeStandalone:
+		config, err := s.standalones.Get(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+		if err != nil {
+			return nil, err
+		}
+		return config, nil
+	case domain.ConfTypeGroup:
+		config, err := s.groups.Get(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+		if err != nil {
+			return nil, err
+		}
+		return config, nil
+	default:
+		return nil, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("Unknown config type: %s", ref.Type))
+	}
 }
 
-func selectRandmNodes(nodes []*magnetarapi.NodeStringified, percentage int32) []*magnetarapi.NodeStringified {
-	totalNodes := len(nodes)
-	numberOfNodesToSelect := int(math.Ceil(float64(totalNodes) * float64(percentage) / 100))
+func (s *PlacementService) ListStrategies() []PlacementStrategy {
+	return s.strategies.List()
+}
 
-	r := rand.New(rand.NewSource(time.Now().Unix()))
+// List returns the config's tasks, only those of the placement with the given
+// id if it's set.
+func (s *PlacementService) List(ctx context.Context, org domain.Org, namespace, name, version, configType, placementId string, statuses []domain.PlacementTaskStatus) ([]domain.PlacementTask, *domain.Error) {
+	tasks, err := s.store.ListByConfig(ctx, org, namespace, name, version, configType)
+	if err != nil || (len(statuses) == 0 && placementId == "") {
+		return tasks, err
+	}
+	filtered := make([]domain.PlacementTask, 0, len(tasks))
+	for _, task := range tasks {
+		if (len(statuses) == 0 || slices.Contains(statuses, task.Status())) && (placementId == "" || task.PlacementId() == placementId) {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered, nil
+}
 
-	selectedNodes := make([]*magnetarapi.NodeStringified, 0)
+func (s *PlacementService) ListByNode(ctx context.Context, org domain.Org, node domain.Node, statuses []domain.PlacementTaskStatus, pageSize int32, pageToken string) ([]domain.NodePlacement, string, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(org)) {
+		return nil, "", domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	placements, err := s.store.ListByNode(ctx, org, node)
+	if err != nil {
+		return nil, "", err
+	}
 
-	for i := 0; i < numberOfNodesToSelect; i++ {
-		index := r.Intn(len(nodes))
-		selectedNodes = append(selectedNodes, nodes[index])
-		nodes = append(nodes[:index], nodes[index+1:]...)
+	latest := latestPlacementPerConfig(placements)
+	filtered := make([]domain.NodePlacement, 0, len(latest))
+	for _, placement := range latest {
+		if len(statuses) == 0 || slices.Contains(statuses, placement.Task.Status()) {
+			filtered = append(filtered, placement)
+		}
 	}
+	page, nextPageToken := paginate(filtered, nodePlacementKey, pageSize, pageToken)
+	return page, nextPageToken, nil
+}
 
-	return selectedNodes
+func latestPlacementPerConfig(placements []domain.NodePlacement) []domain.NodePlacement {
+	latestByConfig := make(map[string]domain.NodePlacement)
+	for _, placement := range placements {
+		key := nodePlacementKey(placement)
+		latest, ok := latestByConfig[key]
+		if !ok || placement.Task.Sequence() > latest.Task.Sequence() {
+			latestByConfig[key] = placement
+		}
+	}
+	latest := make([]domain.NodePlacement, 0, len(latestByConfig))
+	for _, placement := range latestByConfig {
+		latest = append(latest, placement)
+	}
+	slices.SortFunc(latest, func(a, b domain.NodePlacement) int {
+		return strings.Compare(nodePlacementKey(a), nodePlacementKey(b))
+	})
+	return latest
 }
 
-func (s *PlacementService) List(ctx context.Context, org domain.Org, namespace, name, version, configType string) ([]domain.PlacementTask, *domain.Error) {
-	return s.store.ListByConfig(ctx, org, namespace, name, version, configType)
+func nodePlacementKey(placement domain.NodePlacement) string {
+	return fmt.Sprintf("%s/%s/%s", placement.Config.Type, placement.Config.Namespace, placement.Config.Name)
 }
 
-func (s *PlacementService) UpdateStatus(ctx context.Context, org domain.Org, namespace, name, version, configType, taskId string, status domain.PlacementTaskStatus) *domain.Error {
-	return s.store.UpdateStatus(ctx, org, namespace, name, version, configType, taskId, status)
+func (s *PlacementService) UpdateStatus(ctx context.Context, org domain.Org, namespace, name, version, configType, taskId string, report domain.TaskReport) *domain.Error {
+	if err := s.store.UpdateStatus(ctx, org, namespace, name, version, configType, taskId, report); err != nil {
+		return err
+	}
+	if report.Status != domain.PlacementTaskStatusPlaced {
+		return nil
+	}
+	ref := domain.ConfigRef{Org: org, Namespace: namespace, Name: name, Version: version, Type: configType}
+	if err := s.supersedeRolledBack(ctx, ref, taskId); err != nil {
+		log.Println(err)
+	}
+	return nil
 }
 
 func deseminateConfig(ctx context.Context, nodeId string, cmd []byte, agentQueueClient agent_queue.AgentQueueClient, whUrl string) error {
@@ -163,11 +534,3 @@ func deseminateConfig(ctx context.Context, nodeId string, cmd []byte, agentQueue
 	})
 	return err
 }
-
-func copySelector(selector *magnetarapi.Selector) magnetarapi.Selector {
-	return magnetarapi.Selector{
-		LabelKey: selector.LabelKey,
-		ShouldBe: selector.ShouldBe,
-		Value:    selector.Value,
-	}
-}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+// Cancel withdraws the config's tasks, or only those of the placement or with
+// the ids given, that agents haven't acknowledged yet. When all of them are
+// cancelled, everything that would place the config's version again is
+// stopped first: its active rollouts are aborted, its pending scheduled
+// placements cancelled and its persistent placement deleted.
+func (s *PlacementService) Cancel(ctx context.Context, ref domain.ConfigRef, placementId string, taskIds []string) (*domain.CancelledPlacement, *domain.Error) {
+	config, err := s.config(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizePlace(ctx, config); err != nil {
+		return nil, err
+	}
+	result := &domain.CancelledPlacement{
+		Tasks:              make([]domain.PlacementTask, 0),
+		AbortedRollouts:    make([]string, 0),
+		CancelledSchedules: make([]string, 0),
+	}
+	if len(taskIds) == 0 && placementId == "" {
+		if err := s.stopPlacing(ctx, ref, result); err != nil {
+			return result, err
+		}
+	}
+	tasks, err := s.store.ListByConfig(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version, ref.Type)
+	if err != nil {
+		return result, err
+	}
+	for _, task := range tasks {
+		if task.Status() != domain.PlacementTaskStatusAccepted || (len(taskIds) > 0 && !slices.Contains(taskIds, task.Id())) || (placementId != "" && task.PlacementId() != placementId) {
+			continue
+		}
+		if err := s.store.Cancel(ctx, ref, task.Id()); err != nil {
+			// acknowledged by the agent in the meantime
+			if err.ErrType() != domain.ErrTypeConflict {
+				log.Println(err)
+			}
+			continue
+		}
+		if err := s.retries.Delete(ctx, ref.Org, task.Id()); err != nil {
+			log.Println(err)
+		}
+		cmd, err := cancelConfigCommand(config, task.Id())
+		if err != nil {
+			return result, err
+		}
+		// the task is cancelled either way, the command only spares the agent
+		// from applying a config delivered late
+		if err := s.deseminate(ctx, task.Node(), cmd, config.Type()); err != nil {
+			log.Println(err)
+		}
+		cancelledTask, err := s.store.Get(ctx, ref, task.Id())
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		result.Tasks = append(result.Tasks, *cancelledTask)
+	}
+	return result, nil
+}
+
+func (s *PlacementService) stopPlacing(ctx context.Context, ref domain.ConfigRef, result *domain.CancelledPlacement) *domain.Error {
+	rollouts, err := s.rollouts.ListActive(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rollout := range rollouts {
+		if rollout.Config != ref || rollout.Abort("placement cancelled") != nil {
+			continue
+		}
+		rollout.UpdatedAt = time.Now().Unix()
+		if err := s.rollouts.Update(ctx, rollout); err != nil {
+			return err
+		}
+		result.AbortedRollouts = append(result.AbortedRollouts, rollout.Id)
+	}
+
+	schedules, err := s.schedules.ListByOrg(ctx, ref.Org)
+	if err != nil {
+		return err
+	}
+	for _, schedule := range schedules {
+		if schedule.Config != ref || schedule.Cancel("placement cancelled") != nil {
+			continue
+		}
+		if err := s.schedules.Update(ctx, schedule); err != nil {
+			// started running in the meantime, its tasks are cancelled below
+			// unless they were created after they were listed
+			if err.ErrType() != domain.ErrTypeConflict {
+				return err
+			}
+			continue
+		}
+		result.CancelledSchedules = append(result.CancelledSchedules, schedule.Id)
+	}
+
+	deleted, err := s.persistent.DeleteVersion(ctx, ref)
+	if err != nil {
+		return err
+	}
+	result.PersistentDeleted = deleted != nil
+	return nil
+}
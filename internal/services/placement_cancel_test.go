@@ -0,0 +1,278 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+var placePermissions = []string{
+	"config.get|config|" + OortConfigId(testConfigRef.Type, string(testConfigRef.Org), testConfigRef.Namespace, testConfigRef.Name, testConfigRef.Version),
+	"namespace.putconfig|namespace|org/ns",
+}
+
+func testTask(id, placementId string, node domain.Node, status domain.PlacementTaskStatus) *domain.PlacementTask {
+	task := domain.NewPlacementTask(id, node, status, 0, 0)
+	task.SetPlacementId(placementId)
+	return task
+}
+
+func TestDeliverTaskStoredStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		// what the store holds by the time the task is delivered
+		stored    []*domain.PlacementTask
+		getErr    *domain.Error
+		delivered bool
+		retried   bool
+		status    domain.PlacementTaskStatus
+	}{
+		{
+			name:      "still accepted",
+			stored:    []*domain.PlacementTask{testTask("t1", "p1", "n1", domain.PlacementTaskStatusAccepted)},
+			delivered: true,
+			status:    domain.PlacementTaskStatusAccepted,
+		},
+		{
+			name:   "cancelled after it was stored",
+			stored: []*domain.PlacementTask{testTask("t1", "p1", "n1", domain.PlacementTaskStatusCancelled)},
+			status: domain.PlacementTaskStatusCancelled,
+		},
+		{
+			name:   "timed out before it was delivered",
+			stored: []*domain.PlacementTask{testTask("t1", "p1", "n1", domain.PlacementTaskStatusTimedOut)},
+			status: domain.PlacementTaskStatusTimedOut,
+		},
+		{
+			name:   "deleted",
+			status: domain.PlacementTaskStatusAccepted,
+		},
+		{
+			name:    "store unavailable",
+			stored:  []*domain.PlacementTask{testTask("t1", "p1", "n1", domain.PlacementTaskStatusAccepted)},
+			getErr:  domain.NewError(domain.ErrTypeDb, "etcd unavailable"),
+			retried: true,
+			status:  domain.PlacementTaskStatusAccepted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakePlacementStore(tt.stored...)
+			store.getErr = tt.getErr
+			aq := &fakeAgentQueue{}
+			retries := &fakeRetryStore{}
+			s := newTestPlacementService(store, aq, retries, 1)
+			task := testTask("t1", "p1", "n1", domain.PlacementTaskStatusAccepted)
+			s.deliverTask(context.Background(), testConfig(), task, "query")
+			if delivered := len(aq.sentTo()) > 0; delivered != tt.delivered {
+				t.Errorf("delivered = %t, want %t", delivered, tt.delivered)
+			}
+			if retried := len(retries.put) > 0; retried != tt.retried {
+				t.Errorf("retry queued = %t, want %t", retried, tt.retried)
+			}
+			if task.Status() != tt.status {
+				t.Errorf("task is %s, want %s", task.Status(), tt.status)
+			}
+		})
+	}
+}
+
+func TestDispatchCancelledWhileDelivering(t *testing.T) {
+	store := newFakePlacementStore()
+	aq := &fakeAgentQueue{}
+	s := newTestPlacementService(store, aq, &fakeRetryStore{}, 1)
+	tasks := []*domain.PlacementTask{
+		testTask("t1", "p1", "n1", domain.PlacementTaskStatusAccepted),
+		testTask("t2", "p1", "n2", domain.PlacementTaskStatusAccepted),
+		testTask("t3", "p1", "n3", domain.PlacementTaskStatusAccepted),
+	}
+	// with a single worker the tasks are delivered in order, so the placement
+	// is cancelled while the first one is handed over, the cancellation
+	// commands go through the queue too
+	var started atomic.Bool
+	var cancelled *domain.CancelledPlacement
+	aq.during = func() {
+		if started.Swap(true) {
+			return
+		}
+		var err *domain.Error
+		cancelled, err = s.Cancel(authorizedCtx(t, s.authorizer, placePermissions...), testConfigRef, "p1", nil)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	placed := s.dispatch(context.Background(), testConfig(), tasks, "query")
+	statuses := make([]domain.PlacementTaskStatus, 0)
+	for _, task := range placed {
+		statuses = append(statuses, task.Status())
+	}
+	want := []domain.PlacementTaskStatus{domain.PlacementTaskStatusAccepted, domain.PlacementTaskStatusCancelled, domain.PlacementTaskStatusCancelled}
+	if !slices.Equal(statuses, want) {
+		t.Errorf("statuses = %v, want %v", statuses, want)
+	}
+	if cancelled == nil || len(cancelled.Tasks) != 3 || store.status("t1") != domain.PlacementTaskStatusCancelled {
+		t.Errorf("cancelled = %+v, want all three tasks", cancelled)
+	}
+	// the config only reached n1, every node got the cancellation
+	if got := aq.sentTo(); !slices.Equal(got, []string{"n1", "n1", "n2", "n3"}) {
+		t.Errorf("commands sent to %v, want n1 twice and n2 and n3 once", got)
+	}
+}
+
+type fakeRolloutStore struct {
+	domain.RolloutStore
+	active  []*domain.Rollout
+	updated []string
+}
+
+func (s *fakeRolloutStore) ListActive(ctx context.Context) ([]*domain.Rollout, *domain.Error) {
+	return s.active, nil
+}
+
+func (s *fakeRolloutStore) Update(ctx context.Context, rollout *domain.Rollout) *domain.Error {
+	s.updated = append(s.updated, rollout.Id)
+	return nil
+}
+
+type fakeScheduleStore struct {
+	domain.ScheduledPlacementStore
+	schedules []*domain.ScheduledPlacement
+	// schedules that started running since they were listed
+	started []string
+}
+
+func (s *fakeScheduleStore) ListByOrg(ctx context.Context, org domain.Org) ([]*domain.ScheduledPlacement, *domain.Error) {
+	return s.schedules, nil
+}
+
+func (s *fakeScheduleStore) Create(ctx context.Context, schedule *domain.ScheduledPlacement) *domain.Error {
+	s.schedules = append(s.schedules, schedule)
+	return nil
+}
+
+func (s *fakeScheduleStore) Update(ctx context.Context, schedule *domain.ScheduledPlacement) *domain.Error {
+	if slices.Contains(s.started, schedule.Id) {
+		return domain.NewError(domain.ErrTypeConflict, "schedule changed")
+	}
+	return nil
+}
+
+type fakePersistentStore struct {
+	domain.PersistentPlacementStore
+	placement *domain.PersistentPlacement
+	deleted   bool
+}
+
+func (s *fakePersistentStore) DeleteVersion(ctx context.Context, config domain.ConfigRef) (*domain.PersistentPlacement, *domain.Error) {
+	s.deleted = true
+	return s.placement, nil
+}
+
+func TestPlacementServiceCancel(t *testing.T) {
+	otherVersion := testConfigRef
+	otherVersion.Version = "v2"
+	tests := []struct {
+		name         string
+		placementId  string
+		taskIds      []string
+		permissions  []string
+		unauthorized bool
+		cancelled    []string
+		rollouts     []string
+		schedules    []string
+		persistent   bool
+	}{
+		{
+			name:        "placement",
+			placementId: "p1",
+			permissions: placePermissions,
+			cancelled:   []string{"t1"},
+		},
+		{
+			name:        "tasks",
+			taskIds:     []string{"t2", "t3"},
+			permissions: placePermissions,
+			cancelled:   []string{"t3"},
+		},
+		{
+			name:        "everything",
+			permissions: placePermissions,
+			cancelled:   []string{"t1", "t3"},
+			rollouts:    []string{"r1"},
+			schedules:   []string{"s1"},
+			persistent:  true,
+		},
+		{
+			name:         "unauthorized",
+			permissions:  placePermissions[:1],
+			unauthorized: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakePlacementStore(
+				testTask("t1", "p1", "n1", domain.PlacementTaskStatusAccepted),
+				testTask("t2", "p1", "n2", domain.PlacementTaskStatusInProgress),
+				testTask("t3", "p2", "n3", domain.PlacementTaskStatusAccepted),
+				testTask("t4", "p1", "n4", domain.PlacementTaskStatusAccepted),
+			)
+			store.acknowledged = []string{"t4"}
+			aq := &fakeAgentQueue{}
+			retries := &fakeRetryStore{}
+			rollouts := &fakeRolloutStore{active: []*domain.Rollout{
+				{Id: "r1", Config: testConfigRef, Status: domain.RolloutStatusRunning},
+				{Id: "r2", Config: otherVersion, Status: domain.RolloutStatusRunning},
+			}}
+			schedules := &fakeScheduleStore{
+				schedules: []*domain.ScheduledPlacement{
+					{Id: "s1", Config: testConfigRef, Status: domain.ScheduledPlacementStatusPending},
+					{Id: "s2", Config: testConfigRef, Status: domain.ScheduledPlacementStatusPending},
+					{Id: "s3", Config: otherVersion, Status: domain.ScheduledPlacementStatusPending},
+					{Id: "s4", Config: testConfigRef, Status: domain.ScheduledPlacementStatusCompleted},
+				},
+				started: []string{"s2"},
+			}
+			s := newTestPlacementService(store, aq, retries, 1)
+			s.rollouts = rollouts
+			s.schedules = schedules
+			s.persistent = &fakePersistentStore{placement: &domain.PersistentPlacement{}}
+
+			result, err := s.Cancel(authorizedCtx(t, s.authorizer, tt.permissions...), testConfigRef, tt.placementId, tt.taskIds)
+			if tt.unauthorized {
+				if err == nil || err.ErrType() != domain.ErrTypeUnauthorized {
+					t.Fatalf("err = %v, want unauthorized", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			cancelled := make([]string, 0)
+			for _, task := range result.Tasks {
+				cancelled = append(cancelled, task.Id())
+				if task.Status() != domain.PlacementTaskStatusCancelled {
+					t.Errorf("task %s is %s, want cancelled", task.Id(), task.Status())
+				}
+			}
+			if !slices.Equal(cancelled, tt.cancelled) || !slices.Equal(retries.deleted, tt.cancelled) {
+				t.Errorf("cancelled %v with retries of %v dropped, want %v", cancelled, retries.deleted, tt.cancelled)
+			}
+			if len(aq.sentTo()) != len(tt.cancelled) {
+				t.Errorf("%d cancellations sent, want %d", len(aq.sentTo()), len(tt.cancelled))
+			}
+			if !slices.Equal(result.AbortedRollouts, tt.rollouts) || !slices.Equal(rollouts.updated, tt.rollouts) {
+				t.Errorf("aborted rollouts = %v, want %v", result.AbortedRollouts, tt.rollouts)
+			}
+			if !slices.Equal(result.CancelledSchedules, tt.schedules) {
+				t.Errorf("cancelled schedules = %v, want %v", result.CancelledSchedules, tt.schedules)
+			}
+			if result.PersistentDeleted != tt.persistent {
+				t.Errorf("persistent deleted = %t, want %t", result.PersistentDeleted, tt.persistent)
+			}
+		})
+	}
+}
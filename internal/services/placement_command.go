@@ -0,0 +1,114 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/pkg/api"
+	"google.golang.org/protobuf/proto"
+)
+
+// applyConfigCommand marshals the command agents receive for a placement task.
+func applyConfigCommand(config domain.Config, taskId, strategy string) ([]byte, *domain.Error) {
+	cmd, err := configCommand(config, taskId, strategy)
+	if err != nil {
+		return nil, err
+	}
+	return marshalCommand(cmd)
+}
+
+// removeConfigCommand marshals the command telling the agent to remove the
+// config a placement task applied.
+func removeConfigCommand(config domain.Config, taskId string) ([]byte, *domain.Error) {
+	cmd, err := configCommand(config, taskId, "")
+	if err != nil {
+		return nil, err
+	}
+	cmd.Remove = true
+	return marshalCommand(cmd)
+}
+
+// cancelConfigCommand marshals the command telling the agent to drop the
+// placement task if it hasn't applied it yet.
+func cancelConfigCommand(config domain.Config, taskId string) ([]byte, *domain.Error) {
+	cmd, err := configCommand(config, taskId, "")
+	if err != nil {
+		return nil, err
+	}
+	cmd.Cancel = true
+	return marshalCommand(cmd)
+}
+
+func configCommand(config domain.Config, taskId, strategy string) (*api.ApplyConfigCommand, *domain.Error) {
+	var configMarshalled []byte
+	var cmdType string
+	var err error
+	switch config := config.(type) {
+	case *domain.StandaloneConfig:
+		cmdType = "standalone"
+		configMarshalled, err = proto.Marshal(&api.StandaloneConfig{
+			Organization: string(config.Org()),
+			Namespace:    config.Namespace(),
+			Name:         config.Name(),
+			Version:      config.Version(),
+			CreatedAt:    config.CreatedAtUTC().String(),
+			ParamSet:     mapParamSet(config.ParamSet()),
+			ContentHash:  config.ContentHash(),
+		})
+	case *domain.ConfigGroup:
+		cmdType = "group"
+		configMarshalled, err = proto.Marshal(&api.ConfigGroup{
+			Organization: string(config.Org()),
+			Namespace:    config.Namespace(),
+			Name:         config.Name(),
+			Version:      config.Version(),
+			CreatedAt:    config.CreatedAtUTC().String(),
+			ParamSets:    mapParamSets(config.ParamSets()),
+			ContentHash:  config.ContentHash(),
+		})
+	default:
+		return nil, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("Unknown config type: %s", config.Type()))
+	}
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	return &api.ApplyConfigCommand{
+		TaskId:    taskId,
+		Namespace: config.Namespace(),
+		Config:    configMarshalled,
+		Type:      cmdType,
+		Strategy:  strategy,
+	}, nil
+}
+
+func marshalCommand(cmd *api.ApplyConfigCommand) ([]byte, *domain.Error) {
+	cmdMarshalled, err := proto.Marshal(cmd)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	return cmdMarshalled, nil
+}
+
+func webhookPath(configType string) string {
+	if configType == domain.ConfTypeGroup {
+		return "/groups"
+	}
+	return "/standalone"
+}
+
+func mapParamSet(params map[string]string) []*api.Param {
+	paramSet := make([]*api.Param, 0)
+	for key, value := range params {
+		paramSet = append(paramSet, &api.Param{Key: key, Value: value})
+	}
+	return paramSet
+}
+
+func mapParamSets(paramSets []domain.NamedParamSet) []*api.NamedParamSet {
+	protoParamSets := make([]*api.NamedParamSet, 0)
+	for _, paramSet := range paramSets {
+		params := mapParamSet(paramSet.ParamSet())
+		protoParamSets = append(protoParamSets, &api.NamedParamSet{Name: paramSet.Name(), ParamSet: params})
+	}
+	return protoParamSets
+}
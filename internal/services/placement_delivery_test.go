@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+// acceptedTasks returns n accepted tasks, t1 on node n1 and so on,
+// along with a store holding copies of them.
+func acceptedTasks(n int) ([]*domain.PlacementTask, *fakePlacementStore) {
+	tasks := make([]*domain.PlacementTask, 0, n)
+	store := newFakePlacementStore()
+	for i := 1; i <= n; i++ {
+		task := testTask(fmt.Sprintf("t%d", i), "p1", domain.Node(fmt.Sprintf("n%d", i)), domain.PlacementTaskStatusAccepted)
+		tasks = append(tasks, task)
+		stored := *task
+		store.tasks = append(store.tasks, &stored)
+	}
+	return tasks, store
+}
+
+func taskNodes(tasks []*domain.PlacementTask) []string {
+	nodes := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		nodes = append(nodes, string(task.Node()))
+	}
+	slices.Sort(nodes)
+	return nodes
+}
+
+func TestForEachWorkers(t *testing.T) {
+	tests := []struct {
+		name    string
+		workers int
+		n       int
+		limit   int
+	}{
+		{name: "single worker", workers: 1, n: 5, limit: 1},
+		{name: "fewer workers than calls", workers: 3, n: 10, limit: 3},
+		{name: "more workers than calls", workers: 8, n: 2, limit: 2},
+		{name: "no workers runs one at a time", workers: 0, n: 3, limit: 1},
+		{name: "no calls", workers: 2, n: 0, limit: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &PlacementService{workers: tt.workers}
+			var active, maxActive atomic.Int32
+			called := make([]bool, tt.n)
+			s.forEach(tt.n, func(i int) {
+				current := active.Add(1)
+				for {
+					seen := maxActive.Load()
+					if current <= seen || maxActive.CompareAndSwap(seen, current) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				called[i] = true
+				active.Add(-1)
+			})
+			if int(maxActive.Load()) > tt.limit {
+				t.Errorf("%d calls ran at once, want at most %d", maxActive.Load(), tt.limit)
+			}
+			if slices.Contains(called, false) {
+				t.Errorf("called = %v, want every index called", called)
+			}
+		})
+	}
+}
+
+func TestDispatchFanOut(t *testing.T) {
+	tests := []struct {
+		name    string
+		workers int
+		tasks   int
+	}{
+		{name: "single worker", workers: 1, tasks: 4},
+		{name: "bounded", workers: 3, tasks: 12},
+		{name: "more workers than tasks", workers: 16, tasks: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks, _ := acceptedTasks(tt.tasks)
+			aq := &fakeAgentQueue{during: func() { time.Sleep(2 * time.Millisecond) }}
+			s := newTestPlacementService(newFakePlacementStore(), aq, &fakeRetryStore{}, tt.workers)
+
+			placed := s.dispatch(context.Background(), testConfig(), tasks, "query")
+			if len(placed) != tt.tasks {
+				t.Errorf("placed %d tasks, want %d", len(placed), tt.tasks)
+			}
+			if got := aq.sentTo(); !slices.Equal(got, taskNodes(tasks)) {
+				t.Errorf("config sent to %v, want %v", got, taskNodes(tasks))
+			}
+			if aq.maxActive > tt.workers {
+				t.Errorf("%d configs handed over at once, want at most %d", aq.maxActive, tt.workers)
+			}
+		})
+	}
+}
+
+func TestDeliverAsync(t *testing.T) {
+	tests := []struct {
+		name      string
+		stopFirst bool
+	}{
+		{name: "delivered before stopping"},
+		{name: "handed over once stopped", stopFirst: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks, store := acceptedTasks(5)
+			aq := &fakeAgentQueue{}
+			retries := &fakeRetryStore{}
+			s := newTestPlacementService(store, aq, retries, 2)
+
+			if tt.stopFirst {
+				s.StopDeliveries()
+			}
+			s.deliverAsync(context.Background(), testConfig(), tasks, "query")
+			// stopping right away would hand the tasks to the retry queue before
+			// they're delivered
+			s.deliveries.Wait()
+			s.StopDeliveries()
+
+			if tt.stopFirst {
+				if len(aq.sentTo()) != 0 {
+					t.Errorf("config sent to %v, want it left to the retry queue", aq.sentTo())
+				}
+				if len(retries.put) != len(tasks) {
+					t.Fatalf("%d tasks handed to the retry queue, want %d", len(retries.put), len(tasks))
+				}
+				for _, retry := range retries.put {
+					if retry.Attempts != 0 || retry.Config != testConfigRef {
+						t.Errorf("retry = %+v, want a first attempt of %+v", retry, testConfigRef)
+					}
+				}
+				return
+			}
+			if got := aq.sentTo(); !slices.Equal(got, taskNodes(tasks)) {
+				t.Errorf("config sent to %v, want %v", got, taskNodes(tasks))
+			}
+			if len(retries.put) != 0 {
+				t.Errorf("retries = %+v, want none", retries.put)
+			}
+		})
+	}
+}
+
+func TestDispatchStoppedWhileDelivering(t *testing.T) {
+	tasks, _ := acceptedTasks(3)
+	aq := &fakeAgentQueue{}
+	retries := &fakeRetryStore{}
+	s := newTestPlacementService(newFakePlacementStore(), aq, retries, 1)
+	// with a single worker the tasks are delivered in order, so deliveries
+	// are stopped while the first one is handed over
+	var stop sync.Once
+	aq.during = func() {
+		stop.Do(s.StopDeliveries)
+	}
+
+	s.dispatch(context.Background(), testConfig(), tasks, "query")
+	if got := aq.sentTo(); !slices.Equal(got, []string{"n1"}) {
+		t.Errorf("config sent to %v, want only n1", got)
+	}
+	handedOver := make([]string, 0)
+	for _, retry := range retries.put {
+		handedOver = append(handedOver, retry.TaskId)
+	}
+	slices.Sort(handedOver)
+	if !slices.Equal(handedOver, []string{"t2", "t3"}) {
+		t.Errorf("handed over %v to the retry queue, want t2 and t3", handedOver)
+	}
+}
+
+func TestDeliveryAttempts(t *testing.T) {
+	tests := []struct {
+		name    string
+		stopped bool
+		aqErr   error
+		// attempts of the task once delivered and of its queued retry, -1 if
+		// none is queued
+		attempts      int
+		retryAttempts int
+	}{
+		{name: "delivered", attempts: 1, retryAttempts: -1},
+		{name: "delivery failed", aqErr: errors.New("agent queue unavailable"), attempts: 1, retryAttempts: 1},
+		{name: "handed over when stopped", stopped: true, attempts: 0, retryAttempts: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks, store := acceptedTasks(1)
+			retries := &fakeRetryStore{}
+			s := newTestPlacementService(store, &fakeAgentQueue{err: tt.aqErr}, retries, 1)
+			if tt.stopped {
+				s.StopDeliveries()
+			}
+
+			s.deliverTask(context.Background(), testConfig(), tasks[0], "query")
+			if tasks[0].Attempts() != tt.attempts {
+				t.Errorf("task made %d attempts, want %d", tasks[0].Attempts(), tt.attempts)
+			}
+			stored, _ := store.Get(context.Background(), testConfigRef, "t1")
+			if stored.Attempts() != tt.attempts {
+				t.Errorf("stored task made %d attempts, want %d", stored.Attempts(), tt.attempts)
+			}
+			retryAttempts := -1
+			if len(retries.put) > 0 {
+				retryAttempts = retries.put[0].Attempts
+			}
+			if retryAttempts != tt.retryAttempts {
+				t.Errorf("queued retry made %d attempts, want %d", retryAttempts, tt.retryAttempts)
+			}
+		})
+	}
+}
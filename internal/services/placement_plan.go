@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/pkg/api"
+)
+
+// Plan runs the placement strategy and reports, for every node it selects,
+// which version of the config the node has and what placing this one would
+// change, without creating tasks or sending anything to the agents.
+func (s *PlacementService) Plan(ctx context.Context, ref domain.ConfigRef, strategy *api.PlaceReq_Strategy) ([]domain.PlacementPlan, []domain.ConstraintViolation, *domain.Error) {
+	config, err := s.config(ctx, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.authorizePlace(ctx, config); err != nil {
+		return nil, nil, err
+	}
+	selected, violations, err := s.runStrategy(ctx, config, strategy, mapConstraints(config.Org(), strategy.Constraints))
+	if err != nil {
+		return nil, nil, err
+	}
+	placements, err := s.store.ListByOrg(ctx, ref.Org)
+	if err != nil {
+		return nil, nil, err
+	}
+	current := make(map[domain.Node]domain.ConfigRef)
+	for _, placement := range latestPlacedPerNode(placements) {
+		if placement.Config.SameConfig(ref) {
+			current[placement.Task.Node()] = placement.Config
+		}
+	}
+
+	currentConfigs := make(map[string]domain.Config)
+	plans := make([]domain.PlacementPlan, 0, len(selected))
+	for _, node := range selected {
+		plan := domain.PlacementPlan{
+			Node:   node.Node,
+			Labels: node.Labels,
+		}
+		var currentConfig domain.Config
+		if currentRef, ok := current[plan.Node]; ok {
+			plan.Current = &currentRef
+			currentConfig, ok = currentConfigs[currentRef.Version]
+			if !ok {
+				currentConfig, err = s.config(ctx, currentRef)
+				if err != nil {
+					// the placed version was deleted, the diff is against an empty config
+					log.Println(err)
+				}
+				currentConfigs[currentRef.Version] = currentConfig
+			}
+		}
+		plan.Diffs = configDiffs(config, currentConfig)
+		plans = append(plans, plan)
+	}
+	return plans, violations, nil
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/pkg/api"
+	"google.golang.org/protobuf/proto"
+)
+
+// ReapExpired moves the tasks agents didn't report on before their deadline
+// to TimedOut and publishes an event for each of them. It is meant to be run
+// periodically by a single Kuiper instance.
+func (s *PlacementService) ReapExpired(ctx context.Context) {
+	expired, err := s.store.ListExpired(ctx, time.Now())
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, placement := range expired {
+		if err := s.store.TimeOut(ctx, placement.Config, placement.Task.Id()); err != nil {
+			// the agent reported right before the deadline passed
+			if err.ErrType() != domain.ErrTypeConflict {
+				log.Println(err)
+			}
+			continue
+		}
+		log.Printf("task %s on node %s timed out", placement.Task.Id(), placement.Task.Node())
+		if err := s.publishTimeout(placement); err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+func (s *PlacementService) publishTimeout(placement domain.NodePlacement) error {
+	task := placement.Task
+	event := &api.PlacementTaskTimedOut{
+		Placement: &api.NodePlacement{
+			Type: placement.Config.Type,
+			Config: &api.ConfigId{
+				Organization: string(placement.Config.Org),
+				Namespace:    placement.Config.Namespace,
+				Name:         placement.Config.Name,
+				Version:      placement.Config.Version,
+			},
+			Task: &api.PlacementTask{
+				Id:         task.Id(),
+				Node:       string(task.Node()),
+				Status:     domain.PlacementTaskStatusTimedOut.String(),
+				AcceptedAt: task.AcceptedAtUTC().String(),
+				ResolvedAt: time.Now().UTC().String(),
+				RollbackOf: task.RollbackOf(),
+				Deadline:   task.DeadlineUTC().String(),
+				Attempts:   int32(task.Attempts()),
+				Reason:     task.Reason(),
+				Seed:       task.Seed(),
+			},
+		},
+	}
+	msg, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return s.publisher.Publish(msg, api.PlacementTimeoutsSubject)
+}
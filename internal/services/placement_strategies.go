@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"log"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/pkg/api"
+	magnetarapi "github.com/c12s/magnetar/pkg/api"
+	"google.golang.org/grpc/metadata"
+)
+
+type QueryPlacementStrategy struct {
+	magnetar magnetarapi.MagnetarClient
+}
+
+func NewQueryPlacementStrategy(magnetar magnetarapi.MagnetarClient) PlacementStrategy {
+	return &QueryPlacementStrategy{
+		magnetar: magnetar,
+	}
+}
+
+func (s *QueryPlacementStrategy) Name() string {
+	return "default"
+}
+
+func (s *QueryPlacementStrategy) Description() string {
+	return "Places the config on every node of the organization matching the label query."
+}
+
+func (s *QueryPlacementStrategy) Params() []StrategyParam {
+	return []StrategyParam{
+		{Name: "query", Type: "selector list", Description: "label selectors a node has to match", Required: true},
+	}
+}
+
+func (s *QueryPlacementStrategy) Validate(strategy *api.PlaceReq_Strategy) *domain.Error {
+	if strategy.Query == nil {
+		return domain.NewError(domain.ErrTypeSchemaInvalid, "Query is required for default strategy")
+	}
+	return nil
+}
+
+func (s *QueryPlacementStrategy) SelectNodes(ctx context.Context, config domain.Config, strategy *api.PlaceReq_Strategy) ([]*magnetarapi.NodeStringified, *domain.Error) {
+	queryReq := &magnetarapi.QueryOrgOwnedNodesReq{
+		Org: string(config.Org()),
+	}
+	query := make([]*magnetarapi.Selector, 0)
+	for _, selector := range strategy.Query {
+		s := copySelector(selector)
+		query = append(query, &s)
+	}
+	queryReq.Query = query
+	queryResp, err := s.magnetar.QueryOrgOwnedNodes(forwardMetadata(ctx), queryReq)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeInternal, err.Error())
+	}
+	return queryResp.Nodes, nil
+}
+
+type GossipPlacementStrategy struct {
+	magnetar magnetarapi.MagnetarClient
+}
+
+func NewGossipPlacementStrategy(magnetar magnetarapi.MagnetarClient) PlacementStrategy {
+	return &GossipPlacementStrategy{
+		magnetar: magnetar,
+	}
+}
+
+func (s *GossipPlacementStrategy) Name() string {
+	return "gossip"
+}
+
+func (s *GossipPlacementStrategy) Description() string {
+	return "Places the config on a share of the organization's nodes picked by consistent hashing of the config name and lets them spread it further."
+}
+
+func (s *GossipPlacementStrategy) Params() []StrategyParam {
+	return []StrategyParam{
+		{Name: "percentage", Type: "int32", Description: "share of the organization's nodes to place the config on (1-100)", Required: true},
+		{Name: "seed", Type: "string", Description: "mixed into the hash to pick a different set of nodes for the same config"},
+	}
+}
+
+func (s *GossipPlacementStrategy) Validate(strategy *api.PlaceReq_Strategy) *domain.Error {
+	if strategy.Percentage == 0 {
+		return domain.NewError(domain.ErrTypeSchemaInvalid, "Percentage can't be 0 for gossip strategy")
+	}
+	if strategy.Percentage < 0 || strategy.Percentage > 100 {
+		return domain.NewError(domain.ErrTypeSchemaInvalid, "Percentage must be between 1 and 100 for gossip strategy")
+	}
+	return nil
+}
+
+func (s *GossipPlacementStrategy) SelectNodes(ctx context.Context, config domain.Config, strategy *api.PlaceReq_Strategy) ([]*magnetarapi.NodeStringified, *domain.Error) {
+	queryReq := &magnetarapi.ListOrgOwnedNodesReq{
+		Org: string(config.Org()),
+	}
+	queryResp, err := s.magnetar.ListOrgOwnedNodes(forwardMetadata(ctx), queryReq)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeInternal, err.Error())
+	}
+	key := strategy.Seed + "/" + config.Namespace() + "/" + config.Name()
+	return selectHashedNodes(queryResp.Nodes, key, strategy.Percentage), nil
+}
+
+// selectHashedNodes ranks the nodes by a hash of the key and the node id
+// (rendezvous hashing) and takes the top percentage of them. The ranking
+// doesn't depend on the percentage, so raising it only adds nodes, and
+// nodes joining or leaving only shift the selection by a node or two.
+func selectHashedNodes(nodes []*magnetarapi.NodeStringified, key string, percentage int32) []*magnetarapi.NodeStringified {
+	numberOfNodesToSelect := int(math.Ceil(float64(len(nodes)) * float64(percentage) / 100))
+
+	scores := make(map[string]uint64, len(nodes))
+	for _, node := range nodes {
+		// a weaker hash such as FNV ranks ids sharing a prefix together,
+		// regardless of the key
+		sum := sha256.Sum256([]byte(key + "\x00" + node.Id))
+		scores[node.Id] = binary.BigEndian.Uint64(sum[:8])
+	}
+	ranked := slices.Clone(nodes)
+	slices.SortFunc(ranked, func(a, b *magnetarapi.NodeStringified) int {
+		switch {
+		case scores[a.Id] > scores[b.Id]:
+			return -1
+		case scores[a.Id] < scores[b.Id]:
+			return 1
+		}
+		return strings.Compare(a.Id, b.Id)
+	})
+	return ranked[:numberOfNodesToSelect]
+}
+
+// forwardMetadata passes the caller's metadata on to magnetar, unless the
+// context already carries outgoing metadata, e.g. of an impersonated identity.
+func forwardMetadata(ctx context.Context) context.Context {
+	if _, ok := metadata.FromOutgoingContext(ctx); ok {
+		return ctx
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		log.Println("no metadata in ctx when sending req to magnetar")
+		return ctx
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func copySelector(selector *magnetarapi.Selector) magnetarapi.Selector {
+	return magnetarapi.Selector{
+		LabelKey: selector.LabelKey,
+		ShouldBe: selector.ShouldBe,
+		Value:    selector.Value,
+	}
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	magnetarapi "github.com/c12s/magnetar/pkg/api"
+)
+
+func testNodes(n int) []*magnetarapi.NodeStringified {
+	nodes := make([]*magnetarapi.NodeStringified, 0, n)
+	for i := 0; i < n; i++ {
+		nodes = append(nodes, &magnetarapi.NodeStringified{Id: fmt.Sprintf("node-%d", i)})
+	}
+	return nodes
+}
+
+func nodeIds(nodes []*magnetarapi.NodeStringified) []string {
+	ids := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		ids = append(ids, node.Id)
+	}
+	return ids
+}
+
+func TestSelectHashedNodesCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		nodes      int
+		percentage int32
+		want       int
+	}{
+		{name: "no nodes", nodes: 0, percentage: 50, want: 0},
+		{name: "rounds up to a node", nodes: 10, percentage: 1, want: 1},
+		{name: "rounds up", nodes: 10, percentage: 25, want: 3},
+		{name: "exact", nodes: 10, percentage: 50, want: 5},
+		{name: "all nodes", nodes: 7, percentage: 100, want: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := selectHashedNodes(testNodes(tt.nodes), "seed/ns/db", tt.percentage)
+			if len(got) != tt.want {
+				t.Errorf("selected %d nodes, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectHashedNodesStability(t *testing.T) {
+	nodes := testNodes(50)
+	selected := nodeIds(selectHashedNodes(nodes, "seed/ns/db", 20))
+	tests := []struct {
+		name string
+		// nodes and key to select from instead of the original ones
+		nodes []*magnetarapi.NodeStringified
+		key   string
+		// percentage to select instead of 20
+		percentage int32
+		// nodes of the original selection allowed to be missing
+		maxDropped int
+		// whether the selection has to change
+		differs bool
+	}{
+		{
+			name:       "same input",
+			nodes:      nodes,
+			key:        "seed/ns/db",
+			percentage: 20,
+		},
+		{
+			name: "input order doesn't matter",
+			nodes: func() []*magnetarapi.NodeStringified {
+				reversed := slices.Clone(nodes)
+				slices.Reverse(reversed)
+				return reversed
+			}(),
+			key:        "seed/ns/db",
+			percentage: 20,
+		},
+		{
+			name:       "raising the percentage only adds nodes",
+			nodes:      nodes,
+			key:        "seed/ns/db",
+			percentage: 60,
+		},
+		{
+			name:       "a joining node displaces at most one",
+			nodes:      append(slices.Clone(nodes), &magnetarapi.NodeStringified{Id: "node-new"}),
+			key:        "seed/ns/db",
+			percentage: 20,
+			maxDropped: 1,
+		},
+		{
+			name:       "a leaving node is replaced by one",
+			nodes:      slices.DeleteFunc(slices.Clone(nodes), func(node *magnetarapi.NodeStringified) bool { return node.Id == selected[0] }),
+			key:        "seed/ns/db",
+			percentage: 20,
+			maxDropped: 1,
+		},
+		{
+			name:       "another seed picks other nodes",
+			nodes:      nodes,
+			key:        "other/ns/db",
+			percentage: 20,
+			maxDropped: len(selected),
+			differs:    true,
+		},
+		{
+			name:       "another config picks other nodes",
+			nodes:      nodes,
+			key:        "seed/ns/cache",
+			percentage: 20,
+			maxDropped: len(selected),
+			differs:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := nodeIds(selectHashedNodes(tt.nodes, tt.key, tt.percentage))
+			dropped := 0
+			for _, id := range selected {
+				if !slices.Contains(got, id) {
+					dropped++
+				}
+			}
+			if dropped > tt.maxDropped {
+				t.Errorf("%d of the selected nodes dropped, want at most %d", dropped, tt.maxDropped)
+			}
+			if tt.differs && dropped == 0 {
+				t.Errorf("selection didn't change")
+			}
+		})
+	}
+}
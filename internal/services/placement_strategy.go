@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/pkg/api"
+	magnetarapi "github.com/c12s/magnetar/pkg/api"
+)
+
+type StrategyParam struct {
+	Name        string
+	Type        string
+	Description string
+	Required    bool
+}
+
+// PlacementStrategy decides which nodes a config gets placed on.
+type PlacementStrategy interface {
+	Name() string
+	Description() string
+	Params() []StrategyParam
+	// Validate checks the strategy parameters before any node is selected.
+	Validate(strategy *api.PlaceReq_Strategy) *domain.Error
+	SelectNodes(ctx context.Context, config domain.Config, strategy *api.PlaceReq_Strategy) ([]*magnetarapi.NodeStringified, *domain.Error)
+}
+
+type PlacementStrategyRegistry struct {
+	strategies map[string]PlacementStrategy
+	lock       sync.RWMutex
+}
+
+func NewPlacementStrategyRegistry() *PlacementStrategyRegistry {
+	return &PlacementStrategyRegistry{
+		strategies: make(map[string]PlacementStrategy),
+	}
+}
+
+func (r *PlacementStrategyRegistry) Register(strategy PlacementStrategy) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, ok := r.strategies[strategy.Name()]; ok {
+		return fmt.Errorf("placement strategy %s already registered", strategy.Name())
+	}
+	r.strategies[strategy.Name()] = strategy
+	return nil
+}
+
+func (r *PlacementStrategyRegistry) Get(name string) (PlacementStrategy, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	strategy, ok := r.strategies[name]
+	return strategy, ok
+}
+
+func (r *PlacementStrategyRegistry) List() []PlacementStrategy {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	strategies := make([]PlacementStrategy, 0, len(r.strategies))
+	for _, strategy := range r.strategies {
+		strategies = append(strategies, strategy)
+	}
+	slices.SortFunc(strategies, func(a, b PlacementStrategy) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return strategies
+}
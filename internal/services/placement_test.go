@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/pkg/client/agent_queue"
+	"google.golang.org/grpc"
+)
+
+var testConfigRef = domain.ConfigRef{Org: "org", Namespace: "ns", Name: "db", Version: "v1", Type: domain.ConfTypeStandalone}
+
+// authorizedCtx returns a context carrying a token with the permissions.
+func authorizedCtx(t *testing.T, authorizer *AuthZService, permissions ...string) context.Context {
+	t.Helper()
+	ctx, err := authorizer.Impersonate(context.Background(), domain.ServiceIdentity{Org: "org", Subject: "test", Permissions: permissions})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ctx
+}
+
+// fakePlacementStore keeps the tasks of testConfigRef in memory. Methods the
+// tests don't need panic through the nil embedded interface.
+type fakePlacementStore struct {
+	domain.PlacementStore
+	mu    sync.Mutex
+	tasks []*domain.PlacementTask
+	// sent on the channel Watch returns, which is then closed if closeWatch is set
+	changes    []domain.PlacementTask
+	closeWatch bool
+	// tasks the agent acknowledged right before they were cancelled
+	acknowledged []string
+	// returned by Get instead of the task if set
+	getErr *domain.Error
+}
+
+func newFakePlacementStore(tasks ...*domain.PlacementTask) *fakePlacementStore {
+	return &fakePlacementStore{tasks: tasks}
+}
+
+func (s *fakePlacementStore) task(taskId string) *domain.PlacementTask {
+	for _, task := range s.tasks {
+		if task.Id() == taskId {
+			return task
+		}
+	}
+	return nil
+}
+
+func (s *fakePlacementStore) Get(ctx context.Context, config domain.ConfigRef, taskId string) (*domain.PlacementTask, *domain.Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.getErr != nil {
+		return nil, s.getErr
+	}
+	task := s.task(taskId)
+	if task == nil {
+		return nil, domain.NewError(domain.ErrTypeNotFound, "task not found")
+	}
+	stored := *task
+	return &stored, nil
+}
+
+func (s *fakePlacementStore) Watch(ctx context.Context, config domain.ConfigRef) ([]domain.PlacementTask, <-chan domain.PlacementTask, *domain.Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tasks := make([]domain.PlacementTask, 0, len(s.tasks))
+	for _, task := range s.tasks {
+		tasks = append(tasks, *task)
+	}
+	changes := make(chan domain.PlacementTask, len(s.changes))
+	for _, change := range s.changes {
+		changes <- change
+	}
+	if s.closeWatch {
+		close(changes)
+	}
+	return tasks, changes, nil
+}
+
+func (s *fakePlacementStore) Place(ctx context.Context, config domain.Config, task *domain.PlacementTask) *domain.Error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := *task
+	s.tasks = append(s.tasks, &stored)
+	return nil
+}
+
+func (s *fakePlacementStore) ListByConfig(ctx context.Context, org domain.Org, namespace, name, version, configType string) ([]domain.PlacementTask, *domain.Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tasks := make([]domain.PlacementTask, 0, len(s.tasks))
+	for _, task := range s.tasks {
+		tasks = append(tasks, *task)
+	}
+	return tasks, nil
+}
+
+func (s *fakePlacementStore) Cancel(ctx context.Context, config domain.ConfigRef, taskId string) *domain.Error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	task := s.task(taskId)
+	if task == nil {
+		return domain.NewError(domain.ErrTypeNotFound, "task not found")
+	}
+	if slices.Contains(s.acknowledged, taskId) {
+		task.Resolve(domain.PlacementTaskStatusInProgress, "", 0)
+	}
+	if task.Status() != domain.PlacementTaskStatusAccepted {
+		return domain.NewError(domain.ErrTypeConflict, "task already acknowledged")
+	}
+	task.Resolve(domain.PlacementTaskStatusCancelled, "", 0)
+	return nil
+}
+
+func (s *fakePlacementStore) RecordDelivery(ctx context.Context, config domain.ConfigRef, taskId string, attempts int, deliveryErr string) *domain.Error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	task := s.task(taskId)
+	if task == nil {
+		return domain.NewError(domain.ErrTypeNotFound, "task not found")
+	}
+	task.SetAttempts(attempts)
+	task.SetReason(deliveryErr)
+	return nil
+}
+
+func (s *fakePlacementStore) status(taskId string) domain.PlacementTaskStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.task(taskId).Status()
+}
+
+// fakeAgentQueue records the commands handed to it and how many of them were
+// being handed over at once.
+type fakeAgentQueue struct {
+	mu        sync.Mutex
+	nodes     []string
+	active    int
+	maxActive int
+	// called while a command is being handed over
+	during func()
+	err    error
+}
+
+func (q *fakeAgentQueue) DeseminateConfig(ctx context.Context, in *agent_queue.DeseminateConfigRequest, opts ...grpc.CallOption) (*agent_queue.DeseminateConfigResponse, error) {
+	q.mu.Lock()
+	q.nodes = append(q.nodes, in.NodeId)
+	q.active++
+	q.maxActive = max(q.maxActive, q.active)
+	q.mu.Unlock()
+	if q.during != nil {
+		q.during()
+	}
+	q.mu.Lock()
+	q.active--
+	q.mu.Unlock()
+	return &agent_queue.DeseminateConfigResponse{}, q.err
+}
+
+func (q *fakeAgentQueue) sentTo() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	nodes := slices.Clone(q.nodes)
+	slices.Sort(nodes)
+	return nodes
+}
+
+type fakeStandaloneStore struct {
+	domain.StandaloneConfigStore
+	config *domain.StandaloneConfig
+}
+
+func (s fakeStandaloneStore) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.StandaloneConfig, *domain.Error) {
+	return s.config, nil
+}
+
+type fakeWindowStore struct {
+	domain.MaintenanceWindowStore
+	windows []domain.MaintenanceWindow
+}
+
+func (s fakeWindowStore) ListByOrg(ctx context.Context, org domain.Org) ([]domain.MaintenanceWindow, *domain.Error) {
+	return s.windows, nil
+}
+
+type fakeRetryStore struct {
+	domain.DeliveryRetryStore
+	mu      sync.Mutex
+	put     []domain.DeliveryRetry
+	deleted []string
+}
+
+func (s *fakeRetryStore) Put(ctx context.Context, retry domain.DeliveryRetry) *domain.Error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put = append(s.put, retry)
+	return nil
+}
+
+func (s *fakeRetryStore) Delete(ctx context.Context, org domain.Org, taskId string) *domain.Error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleted = append(s.deleted, taskId)
+	return nil
+}
+
+func testConfig() *domain.StandaloneConfig {
+	return domain.NewStandaloneConfig(testConfigRef.Org, testConfigRef.Namespace, testConfigRef.Version, *domain.NewParamSet(testConfigRef.Name, map[string]string{"k": "v"}))
+}
+
+// newTestPlacementService returns a service delivering testConfig through
+// the fakes, with everything else left unset.
+func newTestPlacementService(store *fakePlacementStore, aq *fakeAgentQueue, retries *fakeRetryStore, workers int) *PlacementService {
+	return &PlacementService{
+		aq:              aq,
+		authorizer:      NewAuthZService("test-key"),
+		store:           store,
+		standalones:     fakeStandaloneStore{config: testConfig()},
+		retries:         retries,
+		maxAttempts:     3,
+		workers:         workers,
+		deliveryTimeout: time.Second,
+		stopping:        make(chan struct{}),
+	}
+}
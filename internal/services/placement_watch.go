@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+// Watch sends the config's tasks, or only those of the placement or with the
+// ids given, and then every change to them as agents report on them. It
+// returns a summary once all of them are resolved or the timeout passes,
+// whichever comes first.
+func (s *PlacementService) Watch(ctx context.Context, ref domain.ConfigRef, placementId string, taskIds []string, timeout time.Duration, send func(task domain.PlacementTask) error) (*domain.PlacementSummary, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(ref.Type, string(ref.Org), ref.Namespace, ref.Name, ref.Version)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	if timeout <= 0 {
+		timeout = s.timeout
+	}
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	tasks, changes, err := s.store.Watch(watchCtx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	watched := func(task domain.PlacementTask) bool {
+		return (len(taskIds) == 0 || slices.Contains(taskIds, task.Id())) && (placementId == "" || task.PlacementId() == placementId)
+	}
+	statuses := make(map[string]domain.PlacementTaskStatus)
+	for _, task := range tasks {
+		if !watched(task) {
+			continue
+		}
+		statuses[task.Id()] = task.Status()
+		if err := send(task); err != nil {
+			return nil, domain.NewError(domain.ErrTypeInternal, err.Error())
+		}
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for !placementResolved(statuses, taskIds) {
+		select {
+		case task, ok := <-changes:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil, domain.NewError(domain.ErrTypeInternal, ctx.Err().Error())
+				}
+				return nil, domain.NewError(domain.ErrTypeDb, "placement watch ended unexpectedly")
+			}
+			if !watched(task) {
+				continue
+			}
+			statuses[task.Id()] = task.Status()
+			if err := send(task); err != nil {
+				return nil, domain.NewError(domain.ErrTypeInternal, err.Error())
+			}
+		case <-deadline.C:
+			return placementSummary(statuses, false), nil
+		}
+	}
+	return placementSummary(statuses, true), nil
+}
+
+// the placement is resolved once every task is known and no longer pending
+func placementResolved(statuses map[string]domain.PlacementTaskStatus, taskIds []string) bool {
+	for _, id := range taskIds {
+		if _, ok := statuses[id]; !ok {
+			return false
+		}
+	}
+	for _, status := range statuses {
+		if status.Pending() {
+			return false
+		}
+	}
+	return true
+}
+
+func placementSummary(statuses map[string]domain.PlacementTaskStatus, resolved bool) *domain.PlacementSummary {
+	summary := &domain.PlacementSummary{
+		Total:    len(statuses),
+		Statuses: make(map[domain.PlacementTaskStatus]int),
+		Resolved: resolved,
+	}
+	for _, status := range statuses {
+		summary.Statuses[status]++
+	}
+	return summary
+}
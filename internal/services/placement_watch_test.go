@@ -0,0 +1,132 @@
+package services
+
+import (
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+func TestPlacementResolved(t *testing.T) {
+	pending, placed := domain.PlacementTaskStatusInProgress, domain.PlacementTaskStatusPlaced
+	tests := []struct {
+		name     string
+		statuses map[string]domain.PlacementTaskStatus
+		taskIds  []string
+		resolved bool
+	}{
+		{name: "no tasks", statuses: map[string]domain.PlacementTaskStatus{}, resolved: true},
+		{name: "all resolved", statuses: map[string]domain.PlacementTaskStatus{"t1": placed, "t2": domain.PlacementTaskStatusFailed}, resolved: true},
+		{name: "one pending", statuses: map[string]domain.PlacementTaskStatus{"t1": placed, "t2": pending}},
+		{name: "watched task not seen yet", statuses: map[string]domain.PlacementTaskStatus{"t1": placed}, taskIds: []string{"t1", "t2"}},
+		{name: "watched tasks resolved", statuses: map[string]domain.PlacementTaskStatus{"t1": placed, "t2": placed}, taskIds: []string{"t1", "t2"}, resolved: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := placementResolved(tt.statuses, tt.taskIds); got != tt.resolved {
+				t.Errorf("placementResolved() = %t, want %t", got, tt.resolved)
+			}
+		})
+	}
+}
+
+func TestPlacementServiceWatch(t *testing.T) {
+	task := func(id, placementId string, status domain.PlacementTaskStatus) *domain.PlacementTask {
+		task := domain.NewPlacementTask(id, "n1", status, 0, 0)
+		task.SetPlacementId(placementId)
+		return task
+	}
+	authorizer := NewAuthZService("test-key")
+	permission := "config.get|config|" + OortConfigId(testConfigRef.Type, string(testConfigRef.Org), testConfigRef.Namespace, testConfigRef.Name, testConfigRef.Version)
+	tests := []struct {
+		name        string
+		store       *fakePlacementStore
+		placementId string
+		taskIds     []string
+		permissions []string
+		fails       bool
+		errType     domain.ErrorType
+		resolved    bool
+		statuses    map[domain.PlacementTaskStatus]int
+		sent        int
+	}{
+		{
+			name:        "already resolved",
+			store:       newFakePlacementStore(task("t1", "p1", domain.PlacementTaskStatusPlaced)),
+			permissions: []string{permission},
+			resolved:    true,
+			statuses:    map[domain.PlacementTaskStatus]int{domain.PlacementTaskStatusPlaced: 1},
+			sent:        1,
+		},
+		{
+			name: "resolved by a report",
+			store: &fakePlacementStore{
+				tasks:   []*domain.PlacementTask{task("t1", "p1", domain.PlacementTaskStatusAccepted)},
+				changes: []domain.PlacementTask{*task("t1", "p1", domain.PlacementTaskStatusInProgress), *task("t1", "p1", domain.PlacementTaskStatusFailed)},
+			},
+			permissions: []string{permission},
+			resolved:    true,
+			statuses:    map[domain.PlacementTaskStatus]int{domain.PlacementTaskStatusFailed: 1},
+			sent:        3,
+		},
+		{
+			name: "other placements are left out",
+			store: &fakePlacementStore{
+				tasks:   []*domain.PlacementTask{task("t1", "p1", domain.PlacementTaskStatusPlaced), task("t2", "p2", domain.PlacementTaskStatusAccepted)},
+				changes: []domain.PlacementTask{*task("t2", "p2", domain.PlacementTaskStatusInProgress)},
+			},
+			placementId: "p1",
+			permissions: []string{permission},
+			resolved:    true,
+			statuses:    map[domain.PlacementTaskStatus]int{domain.PlacementTaskStatusPlaced: 1},
+			sent:        1,
+		},
+		{
+			name:        "times out waiting for a task",
+			store:       newFakePlacementStore(task("t1", "p1", domain.PlacementTaskStatusPlaced)),
+			taskIds:     []string{"t1", "t2"},
+			permissions: []string{permission},
+			statuses:    map[domain.PlacementTaskStatus]int{domain.PlacementTaskStatusPlaced: 1},
+			sent:        1,
+		},
+		{
+			name:        "watch ended",
+			store:       &fakePlacementStore{tasks: []*domain.PlacementTask{task("t1", "p1", domain.PlacementTaskStatusAccepted)}, closeWatch: true},
+			permissions: []string{permission},
+			fails:       true,
+			errType:     domain.ErrTypeDb,
+		},
+		{
+			name:    "unauthorized",
+			store:   newFakePlacementStore(),
+			fails:   true,
+			errType: domain.ErrTypeUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &PlacementService{authorizer: authorizer, store: tt.store}
+			sent := 0
+			summary, err := s.Watch(authorizedCtx(t, authorizer, tt.permissions...), testConfigRef, tt.placementId, tt.taskIds, 50*time.Millisecond, func(task domain.PlacementTask) error {
+				sent++
+				return nil
+			})
+			if tt.fails {
+				if err == nil || err.ErrType() != tt.errType {
+					t.Fatalf("err = %v, want type %d", err, tt.errType)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if summary.Resolved != tt.resolved || !maps.Equal(summary.Statuses, tt.statuses) || summary.Total != len(tt.statuses) {
+				t.Errorf("summary = %+v, want resolved = %t with %v", summary, tt.resolved, tt.statuses)
+			}
+			if sent != tt.sent {
+				t.Errorf("%d tasks sent, want %d", sent, tt.sent)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+const rollbackStrategy = "rollback"
+
+// Rollback finds the nodes whose latest placed task is for the given config
+// version and places back the version each of them had before. Nodes that
+// never had another version are returned as skipped.
+func (s *PlacementService) Rollback(ctx context.Context, ref domain.ConfigRef) ([]domain.RollbackPlacement, []domain.Node, *domain.Error) {
+	config, err := s.config(ctx, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.authorizePlace(ctx, config); err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkWindow(ctx, ref, time.Now()); err != nil {
+		return nil, nil, err
+	}
+	placements, err := s.store.ListByOrg(ctx, ref.Org)
+	if err != nil {
+		return nil, nil, err
+	}
+	history := make(map[domain.Node][]domain.NodePlacement)
+	for _, placement := range placements {
+		if placement.Config.SameConfig(ref) {
+			history[placement.Task.Node()] = append(history[placement.Task.Node()], placement)
+		}
+	}
+	nodes := make([]domain.Node, 0, len(history))
+	for node := range history {
+		nodes = append(nodes, node)
+	}
+	slices.Sort(nodes)
+
+	rollbacks := make([]domain.RollbackPlacement, 0)
+	skipped := make([]domain.Node, 0)
+	previousConfigs := make(map[string]domain.Config)
+	for _, node := range nodes {
+		latest, previous, affected := rollbackTarget(history[node], ref.Version)
+		if !affected {
+			continue
+		}
+		if previous == nil {
+			skipped = append(skipped, node)
+			continue
+		}
+		previousConfig, ok := previousConfigs[previous.Config.Version]
+		if !ok {
+			previousConfig, err = s.config(ctx, previous.Config)
+			if err == nil {
+				err = s.authorizePlace(ctx, previousConfig)
+			}
+			if err != nil {
+				return rollbacks, skipped, err
+			}
+			previousConfigs[previous.Config.Version] = previousConfig
+		}
+
+		task := newPlacementTasks([]domain.Node{node}, s.timeout, "")[0]
+		// the latest task is only superseded once the agent placed this one,
+		// see supersedeRolledBack
+		task.SetRollbackOf(latest.Task.Id(), latest.Config.Version)
+		placed := s.dispatch(ctx, previousConfig, []*domain.PlacementTask{task}, rollbackStrategy)
+		if len(placed) == 0 {
+			skipped = append(skipped, node)
+			continue
+		}
+		rollbacks = append(rollbacks, domain.RollbackPlacement{
+			Superseded: latest,
+			Restored:   domain.NodePlacement{Config: previous.Config, Task: placed[0]},
+		})
+	}
+	return rollbacks, skipped, nil
+}
+
+// supersedeRolledBack marks the task a placed rollback task rolled back as
+// superseded by it.
+func (s *PlacementService) supersedeRolledBack(ctx context.Context, ref domain.ConfigRef, taskId string) *domain.Error {
+	task, err := s.store.Get(ctx, ref, taskId)
+	if err != nil {
+		return err
+	}
+	if task.RollbackOf() == "" {
+		return nil
+	}
+	rolledBack := ref
+	rolledBack.Version = task.RollbackOfVersion()
+	return s.store.Supersede(ctx, rolledBack, task.RollbackOf(), taskId)
+}
+
+// rollbackTarget returns the node's latest placed task if it is for version,
+// along with the latest successful placement of any other version before it.
+func rollbackTarget(history []domain.NodePlacement, version string) (latest domain.NodePlacement, previous *domain.NodePlacement, affected bool) {
+	slices.SortFunc(history, func(a, b domain.NodePlacement) int {
+		if c := cmp.Compare(b.Task.Sequence(), a.Task.Sequence()); c != 0 {
+			return c
+		}
+		return strings.Compare(b.Task.Id(), a.Task.Id())
+	})
+	latestIndex := slices.IndexFunc(history, func(placement domain.NodePlacement) bool {
+		return placement.Task.Status() == domain.PlacementTaskStatusPlaced
+	})
+	if latestIndex < 0 || history[latestIndex].Config.Version != version {
+		return domain.NodePlacement{}, nil, false
+	}
+	for i := latestIndex + 1; i < len(history); i++ {
+		if history[i].Task.Status().Succeeded() && history[i].Config.Version != version {
+			return history[latestIndex], &history[i], true
+		}
+	}
+	return history[latestIndex], nil, true
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+func TestRollbackTarget(t *testing.T) {
+	placed, failed := domain.PlacementTaskStatusPlaced, domain.PlacementTaskStatusFailed
+	tests := []struct {
+		name     string
+		history  []domain.NodePlacement
+		version  string
+		affected bool
+		latest   string
+		previous string
+	}{
+		{
+			name:    "never placed",
+			history: []domain.NodePlacement{placement("t1", "n1", "db", "v2", failed, 1)},
+			version: "v2",
+		},
+		{
+			name: "node runs another version",
+			history: []domain.NodePlacement{
+				placement("t1", "n1", "db", "v2", placed, 1),
+				placement("t2", "n1", "db", "v3", placed, 2),
+			},
+			version: "v2",
+		},
+		{
+			name:     "nothing to go back to",
+			history:  []domain.NodePlacement{placement("t1", "n1", "db", "v2", placed, 1)},
+			version:  "v2",
+			affected: true,
+			latest:   "t1",
+		},
+		{
+			name: "previous succeeded version",
+			history: []domain.NodePlacement{
+				placement("t1", "n1", "db", "v1", placed, 1),
+				placement("t3", "n1", "db", "v2", placed, 3),
+				placement("t2", "n1", "db", "v0", failed, 2),
+			},
+			version:  "v2",
+			affected: true,
+			latest:   "t3",
+			previous: "t1",
+		},
+		{
+			name: "earlier placements of the same version are skipped",
+			history: []domain.NodePlacement{
+				placement("t1", "n1", "db", "v1", domain.PlacementTaskStatusSuperseded, 1),
+				placement("t2", "n1", "db", "v2", placed, 2),
+				placement("t3", "n1", "db", "v2", placed, 3),
+			},
+			version:  "v2",
+			affected: true,
+			latest:   "t3",
+			previous: "t1",
+		},
+		{
+			name: "failed newer task doesn't hide the placed version",
+			history: []domain.NodePlacement{
+				placement("t1", "n1", "db", "v1", placed, 1),
+				placement("t2", "n1", "db", "v2", placed, 2),
+				placement("t3", "n1", "db", "v3", failed, 3),
+			},
+			version:  "v2",
+			affected: true,
+			latest:   "t2",
+			previous: "t1",
+		},
+		{
+			name: "ids order tasks of the same sequence",
+			history: []domain.NodePlacement{
+				placement("b", "n1", "db", "v2", placed, 1),
+				placement("a", "n1", "db", "v1", placed, 1),
+			},
+			version:  "v2",
+			affected: true,
+			latest:   "b",
+			previous: "a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			latest, previous, affected := rollbackTarget(tt.history, tt.version)
+			if affected != tt.affected {
+				t.Fatalf("affected = %t, want %t", affected, tt.affected)
+			}
+			if !affected {
+				return
+			}
+			if latest.Task.Id() != tt.latest {
+				t.Errorf("latest = %s, want %s", latest.Task.Id(), tt.latest)
+			}
+			previousId := ""
+			if previous != nil {
+				previousId = previous.Task.Id()
+			}
+			if previousId != tt.previous {
+				t.Errorf("previous = %q, want %q", previousId, tt.previous)
+			}
+		})
+	}
+}
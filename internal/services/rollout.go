@@ -0,0 +1,286 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/pkg/api"
+	"github.com/google/uuid"
+)
+
+type RolloutService struct {
+	placements *PlacementService
+	store      domain.RolloutStore
+	authorizer *AuthZService
+}
+
+func NewRolloutService(placements *PlacementService, store domain.RolloutStore, authorizer *AuthZService) *RolloutService {
+	return &RolloutService{
+		placements: placements,
+		store:      store,
+		authorizer: authorizer,
+	}
+}
+
+// Start selects the nodes with the given strategy and places the config on
+// them in stages, starting with the first one right away.
+func (s *RolloutService) Start(ctx context.Context, ref domain.ConfigRef, strategy *api.PlaceReq_Strategy, spec domain.RolloutSpec) (*domain.Rollout, []domain.ConstraintViolation, *domain.Error) {
+	config, err := s.placements.config(ctx, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.placements.authorizePlace(ctx, config); err != nil {
+		return nil, nil, err
+	}
+	if err := s.placements.checkWindow(ctx, ref, time.Now()); err != nil {
+		return nil, nil, err
+	}
+	return s.start(ctx, config, strategy, spec)
+}
+
+func (s *RolloutService) start(ctx context.Context, config domain.Config, strategy *api.PlaceReq_Strategy, spec domain.RolloutSpec) (*domain.Rollout, []domain.ConstraintViolation, *domain.Error) {
+	// the per-label limits apply to every stage rather than the whole rollout
+	constraints := mapConstraints(config.Org(), strategy.Constraints)
+	candidates, violations, err := s.placements.runStrategy(ctx, config, strategy, constraints.WithoutMaxPerLabel())
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(candidates) == 0 {
+		return nil, nil, domain.NewError(domain.ErrTypeSchemaInvalid, "no nodes selected by the placement strategy")
+	}
+	if err := s.placements.replacePersistent(ctx, config); err != nil {
+		return nil, nil, err
+	}
+	// spread the early stages across the whole selection, unless the nodes
+	// were already spread by a label
+	if constraints.SpreadBy == "" {
+		rand.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+	}
+	candidates, stages, violation := constraints.Stage(candidates, spec.Stages(len(candidates)))
+	if violation != nil {
+		violations = append(violations, *violation)
+	}
+	nodes := make([]domain.Node, 0, len(candidates))
+	for _, candidate := range candidates {
+		nodes = append(nodes, candidate.Node)
+	}
+
+	now := time.Now().Unix()
+	rollout := &domain.Rollout{
+		Id:              uuid.New().String(),
+		Kind:            spec.Kind,
+		Config:          domain.NewConfigRef(config),
+		Strategy:        strategy.Name,
+		Seed:            strategy.Seed,
+		Nodes:           nodes,
+		Stages:          stages,
+		MaxFailureRatio: spec.FailureRatio(),
+		MaxFailures:     spec.MaxFailures,
+		Timeout:         spec.Timeout,
+		Status:          domain.RolloutStatusRunning,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if err := s.store.Create(ctx, rollout); err != nil {
+		return nil, nil, err
+	}
+	if err := s.step(ctx, rollout); err != nil {
+		log.Println(err)
+	}
+	return rollout, violations, nil
+}
+
+// NewRolloutSpec reads the staged rollout settings of a placement request.
+func NewRolloutSpec(req *api.PlaceReq) domain.RolloutSpec {
+	if req.Rolling != nil {
+		return domain.RolloutSpec{
+			Kind:            domain.RolloutKindRolling,
+			BatchSize:       int(req.Rolling.GetBatchSize()),
+			BatchPercentage: req.Rolling.GetBatchPercentage(),
+			MaxFailures:     int(req.Rolling.MaxFailures),
+			Timeout:         time.Duration(req.TimeoutSeconds) * time.Second,
+		}
+	}
+	return domain.RolloutSpec{
+		Kind:            domain.RolloutKindCanary,
+		Percentages:     req.Rollout.Stages,
+		MaxFailureRatio: req.Rollout.MaxFailureRatio,
+		Timeout:         time.Duration(req.TimeoutSeconds) * time.Second,
+	}
+}
+
+func (s *RolloutService) Get(ctx context.Context, org domain.Org, id string) (*domain.Rollout, *domain.Error) {
+	rollout, err := s.store.Get(ctx, org, id)
+	if err != nil {
+		return nil, err
+	}
+	ref := rollout.Config
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(ref.Type, string(ref.Org), ref.Namespace, ref.Name, ref.Version)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	return rollout, nil
+}
+
+func (s *RolloutService) List(ctx context.Context, org domain.Org, namespace string, activeOnly bool) ([]*domain.Rollout, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(org)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	rollouts, err := s.store.ListByOrg(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]*domain.Rollout, 0, len(rollouts))
+	for _, rollout := range rollouts {
+		if namespace != "" && rollout.Config.Namespace != namespace {
+			continue
+		}
+		if activeOnly && rollout.Status.Terminal() {
+			continue
+		}
+		filtered = append(filtered, rollout)
+	}
+	return filtered, nil
+}
+
+func (s *RolloutService) Pause(ctx context.Context, org domain.Org, id string) (*domain.Rollout, *domain.Error) {
+	return s.update(ctx, org, id, func(rollout *domain.Rollout) *domain.Error {
+		return rollout.Pause("paused by user")
+	})
+}
+
+func (s *RolloutService) Resume(ctx context.Context, org domain.Org, id string) (*domain.Rollout, *domain.Error) {
+	return s.update(ctx, org, id, func(rollout *domain.Rollout) *domain.Error {
+		return rollout.Resume()
+	})
+}
+
+func (s *RolloutService) Promote(ctx context.Context, org domain.Org, id string, full bool) (*domain.Rollout, *domain.Error) {
+	return s.update(ctx, org, id, func(rollout *domain.Rollout) *domain.Error {
+		return rollout.Promote(full)
+	})
+}
+
+func (s *RolloutService) Abort(ctx context.Context, org domain.Org, id string) (*domain.Rollout, *domain.Error) {
+	return s.update(ctx, org, id, func(rollout *domain.Rollout) *domain.Error {
+		return rollout.Abort("aborted by user")
+	})
+}
+
+func (s *RolloutService) update(ctx context.Context, org domain.Org, id string, change func(rollout *domain.Rollout) *domain.Error) (*domain.Rollout, *domain.Error) {
+	rollout, err := s.store.Get(ctx, org, id)
+	if err != nil {
+		return nil, err
+	}
+	if !s.authorizer.Authorize(ctx, PermNsPut, OortResNamespace, fmt.Sprintf("%s/%s", rollout.Config.Org, rollout.Config.Namespace)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermNsPut))
+	}
+	if err := change(rollout); err != nil {
+		return nil, err
+	}
+	rollout.UpdatedAt = time.Now().Unix()
+	if err := s.store.Update(ctx, rollout); err != nil {
+		return nil, err
+	}
+	if err := s.step(ctx, rollout); err != nil {
+		log.Println(err)
+	}
+	return rollout, nil
+}
+
+// Reconcile moves every active rollout forward. It is meant to be run
+// periodically by a single Kuiper instance.
+func (s *RolloutService) Reconcile(ctx context.Context) {
+	rollouts, err := s.store.ListActive(ctx)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, rollout := range rollouts {
+		if err := s.step(ctx, rollout); err != nil {
+			log.Printf("rollout %s: %s", rollout.Id, err.Message())
+		}
+	}
+}
+
+// step refreshes the task statuses and then either sends the config to the
+// nodes of the current stage or evaluates the stage's health gate.
+func (s *RolloutService) step(ctx context.Context, rollout *domain.Rollout) *domain.Error {
+	changed, err := s.refreshTasks(ctx, rollout)
+	if err != nil {
+		return err
+	}
+	if rollout.Status != domain.RolloutStatusRunning {
+		return s.save(ctx, rollout, changed)
+	}
+	pending := rollout.PendingNodes()
+	if len(pending) == 0 {
+		return s.save(ctx, rollout, rollout.Advance() || changed)
+	}
+	// every stage is held until a maintenance window opens, not only the
+	// first one
+	if err := s.placements.checkWindow(ctx, rollout.Config, time.Now()); err != nil {
+		if err.ErrType() != domain.ErrTypeFailedPrecondition {
+			return err
+		}
+		return s.save(ctx, rollout, changed)
+	}
+
+	config, err := s.placements.config(ctx, rollout.Config)
+	if err != nil {
+		rollout.Status = domain.RolloutStatusPaused
+		rollout.Reason = fmt.Sprintf("config can't be loaded: %s", err.Message())
+		return s.save(ctx, rollout, true)
+	}
+	// the tasks are recorded before anything is sent so that a conflicting
+	// update or a restart can't send the config to the same nodes twice
+	tasks := newPlacementTasks(pending, s.placements.taskTimeout(rollout.Timeout), rollout.Seed)
+	for _, task := range tasks {
+		rollout.Tasks = append(rollout.Tasks, domain.RolloutTask{
+			Node:   task.Node(),
+			TaskId: task.Id(),
+			Stage:  rollout.CurrentStage,
+			Status: task.Status(),
+		})
+	}
+	rollout.Dispatched = rollout.Stages[rollout.CurrentStage]
+	if err := s.save(ctx, rollout, true); err != nil {
+		return err
+	}
+	s.placements.dispatch(ctx, config, tasks, rollout.Strategy)
+	return nil
+}
+
+func (s *RolloutService) refreshTasks(ctx context.Context, rollout *domain.Rollout) (bool, *domain.Error) {
+	ref := rollout.Config
+	tasks, err := s.placements.store.ListByConfig(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version, ref.Type)
+	if err != nil {
+		return false, err
+	}
+	statuses := make(map[string]domain.PlacementTaskStatus, len(tasks))
+	for _, task := range tasks {
+		statuses[task.Id()] = task.Status()
+	}
+	changed := false
+	for i, task := range rollout.Tasks {
+		status, ok := statuses[task.TaskId]
+		if ok && status != task.Status {
+			rollout.Tasks[i].Status = status
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+func (s *RolloutService) save(ctx context.Context, rollout *domain.Rollout, changed bool) *domain.Error {
+	if !changed {
+		return nil
+	}
+	rollout.UpdatedAt = time.Now().Unix()
+	return s.store.Update(ctx, rollout)
+}
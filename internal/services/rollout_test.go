@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+func TestRolloutStageWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		windows []domain.MaintenanceWindow
+		held    bool
+	}{
+		{name: "no windows"},
+		{
+			name:    "window open",
+			windows: []domain.MaintenanceWindow{{Org: "org", Namespace: "ns", Name: "always", Schedule: "* * * * *", Duration: time.Hour}},
+		},
+		{
+			// only opens on new year's day
+			name:    "window closed",
+			windows: []domain.MaintenanceWindow{{Org: "org", Namespace: "ns", Name: "yearly", Schedule: "0 0 1 1 *", Duration: time.Minute}},
+			held:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the first stage went out while the window was open and succeeded
+			store := newFakePlacementStore(testTask("t1", "", "n1", domain.PlacementTaskStatusPlaced))
+			aq := &fakeAgentQueue{}
+			placements := newTestPlacementService(store, aq, &fakeRetryStore{}, 1)
+			placements.windows = fakeWindowStore{windows: tt.windows}
+			rollout := &domain.Rollout{
+				Id:         "r1",
+				Kind:       domain.RolloutKindCanary,
+				Config:     testConfigRef,
+				Nodes:      []domain.Node{"n1", "n2", "n3"},
+				Stages:     []int{1, 3},
+				Dispatched: 1,
+				Tasks:      []domain.RolloutTask{{Node: "n1", TaskId: "t1", Stage: 0, Status: domain.PlacementTaskStatusAccepted}},
+				Status:     domain.RolloutStatusRunning,
+				Timeout:    time.Minute,
+			}
+			s := NewRolloutService(placements, &fakeRolloutStore{active: []*domain.Rollout{rollout}}, placements.authorizer)
+
+			// the first run advances to the second stage, the next one sends it
+			s.Reconcile(context.Background())
+			s.Reconcile(context.Background())
+			if rollout.CurrentStage != 1 {
+				t.Fatalf("rollout is at stage %d, want the second one", rollout.CurrentStage+1)
+			}
+			want := []string{"n2", "n3"}
+			if tt.held {
+				want = []string{}
+			}
+			if got := aq.sentTo(); !slices.Equal(got, want) {
+				t.Errorf("config sent to %v, want %v", got, want)
+			}
+			if dispatched := rollout.Dispatched > 1; dispatched == tt.held {
+				t.Errorf("dispatched %d nodes, want the second stage held = %t", rollout.Dispatched, tt.held)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/pkg/api"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// how long a scheduled placement may run before it's considered interrupted
+const scheduleRunTimeout = 10 * time.Minute
+
+type ScheduleService struct {
+	placements *PlacementService
+	rollouts   *RolloutService
+	store      domain.ScheduledPlacementStore
+	authorizer *AuthZService
+}
+
+func NewScheduleService(placements *PlacementService, rollouts *RolloutService, store domain.ScheduledPlacementStore, authorizer *AuthZService) *ScheduleService {
+	return &ScheduleService{
+		placements: placements,
+		rollouts:   rollouts,
+		store:      store,
+		authorizer: authorizer,
+	}
+}
+
+// Schedule stores the placement request to be executed once startAt passes.
+// The caller's permissions are checked now and stored with the request, which
+// the scheduler executes with them if they still allow it by then.
+func (s *ScheduleService) Schedule(ctx context.Context, ref domain.ConfigRef, req *api.PlaceReq, startAt time.Time) (*domain.ScheduledPlacement, *domain.Error) {
+	config, err := s.placements.config(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.placements.authorizePlace(ctx, config); err != nil {
+		return nil, err
+	}
+	if err := s.placements.checkWindow(ctx, ref, startAt); err != nil {
+		return nil, err
+	}
+	identity, err := s.authorizer.Identity(ctx, ref.Org)
+	if err != nil {
+		return nil, err
+	}
+	if identity.Expired(startAt) {
+		return nil, domain.NewError(domain.ErrTypeFailedPrecondition, fmt.Sprintf("the token expires at %s, before the placement starts", time.Unix(identity.ExpiresAt, 0).UTC()))
+	}
+	request, marshalErr := proto.Marshal(req)
+	if marshalErr != nil {
+		return nil, domain.NewError(domain.ErrTypeMarshalSS, marshalErr.Error())
+	}
+	placement := &domain.ScheduledPlacement{
+		Id:        uuid.New().String(),
+		Config:    ref,
+		Request:   request,
+		StartAt:   startAt.Unix(),
+		Status:    domain.ScheduledPlacementStatusPending,
+		CreatedAt: time.Now().Unix(),
+		Identity:  identity,
+	}
+	if err := s.store.Create(ctx, placement); err != nil {
+		return nil, err
+	}
+	return placement, nil
+}
+
+func (s *ScheduleService) List(ctx context.Context, org domain.Org, namespace string) ([]*domain.ScheduledPlacement, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(org)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	placements, err := s.store.ListByOrg(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	placements = slices.DeleteFunc(placements, func(placement *domain.ScheduledPlacement) bool {
+		return namespace != "" && placement.Config.Namespace != namespace
+	})
+	slices.SortFunc(placements, func(a, b *domain.ScheduledPlacement) int {
+		return cmp.Compare(a.StartAt, b.StartAt)
+	})
+	return placements, nil
+}
+
+func (s *ScheduleService) Cancel(ctx context.Context, org domain.Org, id string) (*domain.ScheduledPlacement, *domain.Error) {
+	placement, err := s.store.Get(ctx, org, id)
+	if err != nil {
+		return nil, err
+	}
+	if !s.authorizer.Authorize(ctx, PermNsPut, OortResNamespace, fmt.Sprintf("%s/%s", placement.Config.Org, placement.Config.Namespace)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermNsPut))
+	}
+	if err := placement.Cancel("cancelled by user"); err != nil {
+		return nil, err
+	}
+	if err := s.store.Update(ctx, placement); err != nil {
+		return nil, err
+	}
+	return placement, nil
+}
+
+// Run executes the scheduled placements whose start time has passed and fails
+// the ones left running by an instance that stopped. It is meant to be run
+// periodically by a single Kuiper instance.
+func (s *ScheduleService) Run(ctx context.Context) {
+	s.failStuck(ctx)
+	due, err := s.store.ListDue(ctx, time.Now())
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, placement := range due {
+		placement.Status = domain.ScheduledPlacementStatusRunning
+		placement.StartedAt = time.Now().Unix()
+		if err := s.store.Update(ctx, placement); err != nil {
+			// cancelled in the meantime
+			if err.ErrType() != domain.ErrTypeConflict {
+				log.Println(err)
+			}
+			continue
+		}
+		placement.Status = domain.ScheduledPlacementStatusCompleted
+		if err := s.execute(ctx, placement); err != nil {
+			placement.Status = domain.ScheduledPlacementStatusFailed
+			placement.Reason = err.Message()
+		}
+		placement.ExecutedAt = time.Now().Unix()
+		if err := s.store.Update(ctx, placement); err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+// failStuck fails the placements that have been running for longer than any
+// execution takes. They aren't executed again, as they may already have
+// placed the config on some of the nodes.
+func (s *ScheduleService) failStuck(ctx context.Context) {
+	stuck, err := s.store.ListStuck(ctx, time.Now().Add(-scheduleRunTimeout))
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, placement := range stuck {
+		log.Printf("scheduled placement %s was interrupted while running", placement.Id)
+		placement.Status = domain.ScheduledPlacementStatusFailed
+		placement.Reason = "interrupted while running, the config may have been placed on some of the nodes"
+		placement.ExecutedAt = time.Now().Unix()
+		if err := s.store.Update(ctx, placement); err != nil && err.ErrType() != domain.ErrTypeConflict {
+			log.Println(err)
+		}
+	}
+}
+
+func (s *ScheduleService) execute(ctx context.Context, placement *domain.ScheduledPlacement) *domain.Error {
+	ctx, err := s.authorizer.Impersonate(ctx, placement.Identity)
+	if err != nil {
+		return err
+	}
+	// the windows may have changed since the placement was scheduled
+	if err := s.placements.checkWindow(ctx, placement.Config, time.Now()); err != nil {
+		return err
+	}
+	req := &api.PlaceReq{}
+	if err := proto.Unmarshal(placement.Request, req); err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	config, err := s.placements.config(ctx, placement.Config)
+	if err != nil {
+		return err
+	}
+	if err := s.placements.authorizePlace(ctx, config); err != nil {
+		return err
+	}
+	if req.Rollout != nil || req.Rolling != nil {
+		rollout, _, err := s.rollouts.start(ctx, config, req.Strategy, NewRolloutSpec(req))
+		if err != nil {
+			return err
+		}
+		placement.RolloutId = rollout.Id
+		return nil
+	}
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	_, tasks, _, err := s.placements.place(ctx, config, req.Strategy, timeout, req.Async)
+	if err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		placement.TaskIds = append(placement.TaskIds, task.Id())
+	}
+	if req.Persistent {
+		// reconciled as whoever scheduled it, not for as long as the token it
+		// was executed with
+		return s.placements.persist(ctx, config, req.Strategy, timeout, req.RemoveUnmatched, placement.Identity)
+	}
+	return nil
+}
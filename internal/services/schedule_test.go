@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/c12s/kuiper/pkg/api"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestScheduleTokenExpiry(t *testing.T) {
+	tests := []struct {
+		name    string
+		startIn time.Duration
+		fails   bool
+	}{
+		{name: "starts before the token expires", startIn: time.Minute},
+		{name: "starts after the token expires", startIn: time.Hour, fails: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			placements := newTestPlacementService(newFakePlacementStore(), &fakeAgentQueue{}, &fakeRetryStore{}, 1)
+			placements.windows = fakeWindowStore{}
+			store := &fakeScheduleStore{}
+			s := NewScheduleService(placements, nil, store, placements.authorizer)
+
+			// the token is valid for serviceTokenTTL
+			ctx := authorizedCtx(t, placements.authorizer, placePermissions...)
+			_, err := s.Schedule(ctx, testConfigRef, &api.PlaceReq{}, time.Now().Add(tt.startIn))
+			if tt.fails {
+				if err == nil || err.ErrType() != domain.ErrTypeFailedPrecondition {
+					t.Fatalf("err = %v, want a failed precondition", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(store.schedules) != 1 || store.schedules[0].Identity.ExpiresAt == 0 {
+				t.Errorf("schedules = %+v, want one limited to the token's lifetime", store.schedules)
+			}
+		})
+	}
+}
+
+func TestScheduleExecuteAuthorization(t *testing.T) {
+	tests := []struct {
+		name     string
+		identity domain.ServiceIdentity
+		errType  domain.ErrorType
+	}{
+		{
+			name:     "token expired",
+			identity: domain.ServiceIdentity{Org: "org", Permissions: placePermissions, ExpiresAt: time.Now().Add(-time.Minute).Unix()},
+			errType:  domain.ErrTypeUnauthorized,
+		},
+		{
+			name:     "access revoked",
+			identity: domain.ServiceIdentity{Org: "org", Permissions: placePermissions[:1]},
+			errType:  domain.ErrTypeUnauthorized,
+		},
+		{
+			// authorized, so it fails only once the strategy is looked up
+			name:     "authorized",
+			identity: domain.ServiceIdentity{Org: "org", Permissions: placePermissions, ExpiresAt: time.Now().Add(time.Hour).Unix()},
+			errType:  domain.ErrTypeSchemaInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			placements := newTestPlacementService(newFakePlacementStore(), &fakeAgentQueue{}, &fakeRetryStore{}, 1)
+			placements.windows = fakeWindowStore{}
+			placements.strategies = NewPlacementStrategyRegistry()
+			s := NewScheduleService(placements, nil, &fakeScheduleStore{}, placements.authorizer)
+			request, marshalErr := proto.Marshal(&api.PlaceReq{Strategy: &api.PlaceReq_Strategy{Name: "default"}})
+			if marshalErr != nil {
+				t.Fatal(marshalErr)
+			}
+
+			err := s.execute(context.Background(), &domain.ScheduledPlacement{Id: "s1", Config: testConfigRef, Request: request, Identity: tt.identity})
+			if err == nil || err.ErrType() != tt.errType {
+				t.Errorf("err = %v, want type %d", err, tt.errType)
+			}
+		})
+	}
+}
@@ -12,7 +12,6 @@ import (
 	oortapi "github.com/c12s/oort/pkg/api"
 	quasarapi "github.com/c12s/quasar/proto"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/protobuf/proto"
 	"gopkg.in/yaml.v3"
 )
 
@@ -113,75 +112,90 @@ func (s *StandaloneConfigService) List(ctx context.Context, org domain.Org, name
 	return s.store.List(ctx, org, namespace)
 }
 
+func (s *StandaloneConfigService) Search(ctx context.Context, org domain.Org, filter domain.ConfigFilter, pageSize int32, pageToken string) ([]*domain.StandaloneConfig, string, *domain.Error) {
+	canRead := s.authorizer.NamespaceAccess(ctx, PermConfigGet, string(org))
+	return s.store.Search(ctx, org, pageToken, pageLimit(pageSize), func(config *domain.StandaloneConfig) bool {
+		return filter.MatchConfig(config) && canRead(config.Namespace()) && filter.MatchParamSet(*domain.NewParamSet(config.Name(), config.ParamSet()))
+	})
+}
+
 func (s *StandaloneConfigService) Delete(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.StandaloneConfig, *domain.Error) {
 	if !s.authorizer.Authorize(ctx, PermConfigPut, OortResConfig, OortConfigId(domain.ConfTypeStandalone, string(org), namespace, name, version)) {
 		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigPut))
 	}
-	return s.store.Delete(ctx, org, name, namespace, version)
+	return s.store.Delete(ctx, org, namespace, name, version)
 }
 
-func (s *StandaloneConfigService) Diff(ctx context.Context, referenceOrg domain.Org, referenceNamespace, referenceName, referenceVersion string, diffOrg domain.Org, diffNamespace, diffName, diffVersion string) ([]domain.Diff, *domain.Error) {
+func (s *StandaloneConfigService) Diff(ctx context.Context, referenceOrg domain.Org, referenceNamespace, referenceName, referenceVersion string, diffOrg domain.Org, diffNamespace, diffName, diffVersion string, format domain.PatchFormat) ([]domain.Diff, string, *domain.Error) {
 	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeStandalone, string(referenceOrg), referenceNamespace, referenceName, referenceVersion)) {
-		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+		return nil, "", domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
 	}
 	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeStandalone, string(diffOrg), diffNamespace, diffName, diffVersion)) {
-		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+		return nil, "", domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
 	}
 	reference, err := s.store.Get(ctx, referenceOrg, referenceNamespace, referenceName, referenceVersion)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	diff, err := s.store.Get(ctx, diffOrg, diffNamespace, diffName, diffVersion)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	referenceParams := *domain.NewParamSet(reference.Name(), reference.ParamSet())
+	diffParams := *domain.NewParamSet(diff.Name(), diff.ParamSet())
+	patch, err := paramSetPatch(configLabel(reference), referenceParams, configLabel(diff), diffParams, format)
+	if err != nil {
+		return nil, "", err
 	}
-	return diff.Diff(reference), nil
+	return diff.Diff(reference), patch, nil
 }
 
-func (s *StandaloneConfigService) Place(ctx context.Context, org domain.Org, namespace, name, version string, strategy *api.PlaceReq_Strategy) ([]domain.PlacementTask, *domain.Error) {
-	config, err := s.store.Get(ctx, org, namespace, name, version)
+func (s *StandaloneConfigService) Merge(ctx context.Context, base, ours, theirs domain.ConfigRef, save bool, version string, schema *quasarapi.ConfigSchemaDetails) (*domain.StandaloneConfig, []domain.MergeConflict, bool, *domain.Error) {
+	configs := make([]*domain.StandaloneConfig, 0, 3)
+	for _, ref := range []domain.ConfigRef{base, ours, theirs} {
+		config, err := s.Get(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		configs = append(configs, config)
+	}
+	merged, conflicts := configs[0].Merge(configs[1], configs[2], version)
+	if !save || len(conflicts) > 0 {
+		return merged, conflicts, false, nil
+	}
+	merged, err := s.Put(ctx, merged, schema)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return merged, conflicts, true, nil
+}
+
+func (s *StandaloneConfigService) Patch(ctx context.Context, org domain.Org, namespace, name, version, targetVersion string, ops []domain.PatchOp, jsonPatch []byte, expectedHash string, schema *quasarapi.ConfigSchemaDetails) (*domain.StandaloneConfig, *domain.Error) {
+	source, err := s.Get(ctx, org, namespace, name, version)
 	if err != nil {
 		return nil, err
 	}
-	return s.placements.Place(ctx, config, strategy, func(taskId string) ([]byte, *domain.Error) {
-		config := &api.StandaloneConfig{
-			Organization: string(config.Org()),
-			Namespace:    namespace,
-			Name:         config.Name(),
-			Version:      config.Version(),
-			CreatedAt:    config.CreatedAtUTC().String(),
-			ParamSet:     mapParamSet(config.ParamSet()),
-		}
-		configMarshalled, err := proto.Marshal(config)
-		if err != nil {
-			return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
-		}
-		cmd := &api.ApplyConfigCommand{
-			TaskId:    taskId,
-			Namespace: namespace,
-			Config:    configMarshalled,
-			Type:      "standalone",
-			Strategy:  strategy.Name,
-		}
-		cmdMarshalled, err := proto.Marshal(cmd)
-		if err != nil {
-			return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
-		}
-		return cmdMarshalled, nil
-	}, "/standalone")
+	if expectedHash != "" && expectedHash != source.ContentHash() {
+		return nil, domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) content hash doesn't match the expected one", org, name, version))
+	}
+	patched, err := source.Patch(targetVersion, ops, jsonPatch)
+	if err != nil {
+		return nil, err
+	}
+	return s.Put(ctx, patched, schema)
 }
 
-func (s *StandaloneConfigService) ListPlacementTasks(ctx context.Context, org domain.Org, namespace, name, version string) ([]domain.PlacementTask, *domain.Error) {
-	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeStandalone, string(org), namespace, name, version)) {
-		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+func (s *StandaloneConfigService) Place(ctx context.Context, org domain.Org, namespace, name, version string, strategy *api.PlaceReq_Strategy, timeout time.Duration, async bool) (string, []domain.PlacementTask, []domain.ConstraintViolation, *domain.Error) {
+	config, err := s.store.Get(ctx, org, namespace, name, version)
+	if err != nil {
+		return "", nil, nil, err
 	}
-	return s.placements.List(ctx, org, namespace, name, version, domain.ConfTypeStandalone)
+	return s.placements.Place(ctx, config, strategy, timeout, async)
 }
 
-func mapParamSet(params map[string]string) []*api.Param {
-	paramSet := make([]*api.Param, 0)
-	for key, value := range params {
-		paramSet = append(paramSet, &api.Param{Key: key, Value: value})
+func (s *StandaloneConfigService) ListPlacementTasks(ctx context.Context, org domain.Org, namespace, name, version, placementId string, statuses []domain.PlacementTaskStatus) ([]domain.PlacementTask, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeStandalone, string(org), namespace, name, version)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
 	}
-	return paramSet
+	return s.placements.List(ctx, org, namespace, name, version, domain.ConfTypeStandalone, placementId, statuses)
 }
@@ -3,6 +3,7 @@ package startup
 import (
 	"context"
 	"errors"
+	"expvar"
 	"log"
 	"net"
 	"net/http"
@@ -16,12 +17,21 @@ import (
 	"github.com/c12s/kuiper/internal/configs"
 	"github.com/c12s/kuiper/internal/servers"
 	"github.com/c12s/kuiper/pkg/api"
+	"github.com/c12s/magnetar/pkg/messaging/nats"
 	meridian_api "github.com/c12s/meridian/pkg/api"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
+const (
+	rolloutReconcileInterval    = 5 * time.Second
+	persistentReconcileInterval = 30 * time.Second
+	placementReapInterval       = 10 * time.Second
+	deliveryRetryInterval       = time.Second
+	scheduleInterval            = 10 * time.Second
+)
+
 type app struct {
 	config            *configs.Config
 	grpcServer        *grpc.Server
@@ -49,6 +59,21 @@ func (a *app) init() {
 		etcdConn.Close()
 	})
 
+	backgroundCtx, cancelBackground := context.WithCancel(context.Background())
+	a.shutdownProcesses = append(a.shutdownProcesses, func() {
+		log.Println("stopping background processes")
+		cancelBackground()
+	})
+
+	err = store.MigrateKeyEncoding(context.Background(), etcdConn)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	err = store.IndexDeadlines(context.Background(), etcdConn)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
 	magnetarClient, err := newMagnetarClient(a.config.MagnetarAddress())
 	if err != nil {
 		log.Fatalln(err)
@@ -78,22 +103,93 @@ func (a *app) init() {
 
 	standaloneConfigStore := store.NewStandaloneConfigEtcdStore(etcdConn)
 	configGroupStore := store.NewConfigGroupEtcdStore(etcdConn)
+	if a.config.ConfigCacheSize() > 0 {
+		cacheCtx, cancelCache := context.WithCancel(context.Background())
+		a.shutdownProcesses = append(a.shutdownProcesses, func() {
+			log.Println("stopping config cache watches")
+			cancelCache()
+		})
+		standaloneConfigStore = store.NewStandaloneConfigCachedStore(cacheCtx, etcdConn, standaloneConfigStore, a.config.ConfigCacheSize())
+		configGroupStore = store.NewConfigGroupCachedStore(cacheCtx, etcdConn, configGroupStore, a.config.ConfigCacheSize())
+	}
 	placementStore := store.NewPlacementEtcdStore(etcdConn)
+	appliedConfigStore := store.NewAppliedConfigEtcdStore(etcdConn)
+	rolloutStore := store.NewRolloutEtcdStore(etcdConn)
+	deliveryRetryStore := store.NewDeliveryRetryEtcdStore(etcdConn)
+	maintenanceWindowStore := store.NewMaintenanceWindowEtcdStore(etcdConn)
+	scheduledPlacementStore := store.NewScheduledPlacementEtcdStore(etcdConn)
+	persistentPlacementStore := store.NewPersistentPlacementEtcdStore(etcdConn)
+
+	natsConn, err := newNatsConn(a.config.NatsAddress())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	a.shutdownProcesses = append(a.shutdownProcesses, func() {
+		log.Println("closing nats conn")
+		natsConn.Close()
+	})
+	publisher, err := nats.NewPublisher(natsConn)
+	if err != nil {
+		log.Fatalln(err)
+	}
 
-	placementService := services.NewPlacementStore(magnetarClient, agentQueueClient, administratorClient, authzService, placementStore, a.config.WebhookUrl())
+	strategies := services.NewPlacementStrategyRegistry()
+	for _, strategy := range []services.PlacementStrategy{
+		services.NewQueryPlacementStrategy(magnetarClient),
+		services.NewGossipPlacementStrategy(magnetarClient),
+	} {
+		err := strategies.Register(strategy)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
+	placementService := services.NewPlacementStore(strategies, agentQueueClient, administratorClient, authzService, placementStore, standaloneConfigStore, configGroupStore, a.config.WebhookUrl(), a.config.PlacementTimeout(), publisher, deliveryRetryStore, a.config.DeliveryAttempts(), maintenanceWindowStore, persistentPlacementStore, rolloutStore, scheduledPlacementStore, a.config.DeliveryWorkers(), a.config.DeliveryTimeout())
+	// stopped before the etcd connection is closed, so that undelivered
+	// configs can still be queued for retries
+	a.shutdownProcesses = append(a.shutdownProcesses, func() {
+		log.Println("stopping config deliveries")
+		placementService.StopDeliveries()
+	})
 	standaloneConfigService := services.NewStandaloneConfigService(administratorClient, authzService, standaloneConfigStore, placementService, quasarClient, meridian)
 	configGroupService := services.NewConfigGroupService(administratorClient, authzService, configGroupStore, placementService, quasarClient)
+	runWhileLeader(backgroundCtx, etcdConn, "kuiper/leader/placement-reaper", every(placementReapInterval, placementService.ReapExpired))
+	runWhileLeader(backgroundCtx, etcdConn, "kuiper/leader/delivery-retries", every(deliveryRetryInterval, placementService.RetryDeliveries))
+	runWhileLeader(backgroundCtx, etcdConn, "kuiper/leader/persistent-placements", every(persistentReconcileInterval, placementService.ReconcilePersistent))
+	rolloutService := services.NewRolloutService(placementService, rolloutStore, authzService)
+	runWhileLeader(backgroundCtx, etcdConn, "kuiper/leader/rollouts", every(rolloutReconcileInterval, rolloutService.Reconcile))
+	maintenanceService := services.NewMaintenanceService(authzService, maintenanceWindowStore)
+	scheduleService := services.NewScheduleService(placementService, rolloutService, scheduledPlacementStore, authzService)
+	runWhileLeader(backgroundCtx, etcdConn, "kuiper/leader/scheduler", every(scheduleInterval, scheduleService.Run))
+	diffService := services.NewDiffService(standaloneConfigService, configGroupService)
+	driftService := services.NewDriftService(authzService, placementStore, appliedConfigStore, standaloneConfigStore, configGroupStore)
+
+	reportSubscriber, err := nats.NewSubscriber(natsConn, api.AppliedConfigsSubject, "kuiper")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	err = reportSubscriber.Subscribe(servers.NewAppliedConfigReports(driftService).Handle)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	a.shutdownProcesses = append(a.shutdownProcesses, func() {
+		log.Println("unsubscribing from applied config reports")
+		err := reportSubscriber.Unsubscribe()
+		if err != nil {
+			log.Println(err)
+		}
+	})
 
-	kuiperGrpcServer := servers.NewKuiperServer(standaloneConfigService, configGroupService)
-	s := grpc.NewServer(grpc.UnaryInterceptor(servers.GetAuthInterceptor()))
+	kuiperGrpcServer := servers.NewKuiperServer(standaloneConfigService, configGroupService, placementService, driftService, diffService, rolloutService, maintenanceService, scheduleService)
+	s := grpc.NewServer(grpc.UnaryInterceptor(servers.GetAuthInterceptor()), grpc.StreamInterceptor(servers.GetStreamAuthInterceptor()))
 	api.RegisterKuiperServer(s, kuiperGrpcServer)
 	reflection.Register(s)
 	a.grpcServer = s
 
-	webhooks := servers.NewTaskWebshooks(placementService)
+	webhooks := servers.NewTaskWebshooks(placementService, driftService)
 	router := mux.NewRouter()
 	router.HandleFunc("/standalone", webhooks.UpdateStandaloneConfigTaskStatus).Methods("POST")
 	router.HandleFunc("/groups", webhooks.UpdateConfigGroupTaskStatus).Methods("POST")
+	router.Handle("/debug/vars", expvar.Handler()).Methods("GET")
 	a.taskWebhooks = &http.Server{
 		Addr:    a.config.WebhooksAddress(),
 		Handler: router,
@@ -133,7 +229,8 @@ func (a *app) GracefulStop() {
 		log.Println(err)
 	}
 	a.grpcServer.GracefulStop()
-	for _, shudownProcess := range a.shutdownProcesses {
-		shudownProcess()
+	// stop processes in the reverse order they were started in
+	for i := len(a.shutdownProcesses) - 1; i >= 0; i-- {
+		a.shutdownProcesses[i]()
 	}
 }
@@ -0,0 +1,82 @@
+package startup
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+const (
+	leaderSessionTTL = 10
+	leaderRetryDelay = 5 * time.Second
+)
+
+// runWhileLeader campaigns for leadership of the named election and calls run
+// while this instance holds it. run must return once its ctx is cancelled.
+func runWhileLeader(ctx context.Context, client *clientv3.Client, election string, run func(ctx context.Context)) {
+	candidate, err := os.Hostname()
+	if err != nil {
+		candidate = "kuiper"
+	}
+	go func() {
+		for ctx.Err() == nil {
+			err := lead(ctx, client, election, candidate, run)
+			if err != nil && ctx.Err() == nil {
+				log.Printf("election %s: %s", election, err)
+				select {
+				case <-time.After(leaderRetryDelay):
+				case <-ctx.Done():
+				}
+			}
+		}
+	}()
+}
+
+func lead(ctx context.Context, client *clientv3.Client, election, candidate string, run func(ctx context.Context)) error {
+	session, err := concurrency.NewSession(client, concurrency.WithTTL(leaderSessionTTL), concurrency.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+	e := concurrency.NewElection(session, election)
+	if err := e.Campaign(ctx, candidate); err != nil {
+		return err
+	}
+	log.Printf("leading %s", election)
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-session.Done():
+			log.Printf("lost leadership of %s", election)
+			cancel()
+		case <-leaderCtx.Done():
+		}
+	}()
+	run(leaderCtx)
+
+	resignCtx, cancelResign := context.WithTimeout(context.Background(), time.Second)
+	defer cancelResign()
+	return e.Resign(resignCtx)
+}
+
+// every calls fn at the given interval until ctx is cancelled.
+func every(interval time.Duration, fn func(ctx context.Context)) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			fn(ctx)
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package startup
+
+import (
+	"fmt"
+
+	natsgo "github.com/nats-io/nats.go"
+)
+
+func newNatsConn(address string) (*natsgo.Conn, error) {
+	return natsgo.Connect(fmt.Sprintf("nats://%s", address))
+}
@@ -0,0 +1,141 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type AppliedConfigEtcdStore struct {
+	client *clientv3.Client
+}
+
+func NewAppliedConfigEtcdStore(client *clientv3.Client) domain.AppliedConfigStore {
+	return AppliedConfigEtcdStore{
+		client: client,
+	}
+}
+
+func (s AppliedConfigEtcdStore) Put(ctx context.Context, applied domain.AppliedConfig) *domain.Error {
+	dao := newAppliedConfigDAO(applied)
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	_, err = s.client.KV.Put(ctx, dao.Key(), value)
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return nil
+}
+
+// ReplaceForNode treats the report as a full snapshot of what the node runs,
+// so configs missing from it are removed.
+func (s AppliedConfigEtcdStore) ReplaceForNode(ctx context.Context, org domain.Org, node domain.Node, applied []domain.AppliedConfig) *domain.Error {
+	prefix := AppliedConfigDAO{
+		Org:  string(org),
+		Node: string(node),
+	}.KeyPrefixByNode()
+	ops := []clientv3.Op{clientv3.OpDelete(prefix, clientv3.WithPrefix())}
+	for _, a := range applied {
+		dao := newAppliedConfigDAO(a)
+		value, err := dao.Marshal()
+		if err != nil {
+			return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+		}
+		ops = append(ops, clientv3.OpPut(dao.Key(), value))
+	}
+	_, err := s.client.KV.Txn(ctx).Then(ops...).Commit()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return nil
+}
+
+func (s AppliedConfigEtcdStore) ListByOrg(ctx context.Context, org domain.Org) ([]domain.AppliedConfig, *domain.Error) {
+	prefix := AppliedConfigDAO{
+		Org: string(org),
+	}.KeyPrefixByOrg()
+	resp, err := s.client.KV.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+
+	applied := make([]domain.AppliedConfig, 0, resp.Count)
+	for _, kv := range resp.Kvs {
+		dao, err := NewAppliedConfigDAO(kv.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		applied = append(applied, domain.AppliedConfig{
+			Node: domain.Node(dao.Node),
+			Config: domain.ConfigRef{
+				Org:       domain.Org(dao.Org),
+				Namespace: dao.Namespace,
+				Name:      dao.Name,
+				Version:   dao.Version,
+				Type:      dao.Type,
+			},
+			ContentHash: dao.ContentHash,
+			TaskId:      dao.TaskId,
+			ReportedAt:  dao.ReportedAt,
+		})
+	}
+	return applied, nil
+}
+
+type AppliedConfigDAO struct {
+	Org         string
+	Node        string
+	Type        string
+	Namespace   string
+	Name        string
+	Version     string
+	ContentHash string
+	TaskId      string
+	ReportedAt  int64
+}
+
+func newAppliedConfigDAO(applied domain.AppliedConfig) AppliedConfigDAO {
+	return AppliedConfigDAO{
+		Org:         string(applied.Config.Org),
+		Node:        string(applied.Node),
+		Type:        applied.Config.Type,
+		Namespace:   applied.Config.Namespace,
+		Name:        applied.Config.Name,
+		Version:     applied.Config.Version,
+		ContentHash: applied.ContentHash,
+		TaskId:      applied.TaskId,
+		ReportedAt:  applied.ReportedAt,
+	}
+}
+
+func (dao AppliedConfigDAO) Key() string {
+	return key("applied", dao.Org, dao.Node, dao.Type, dao.Namespace, dao.Name)
+}
+
+func (dao AppliedConfigDAO) KeyPrefixByNode() string {
+	return keyPrefix("applied", dao.Org, dao.Node)
+}
+
+func (dao AppliedConfigDAO) KeyPrefixByOrg() string {
+	return keyPrefix("applied", dao.Org)
+}
+
+func (dao AppliedConfigDAO) Marshal() (string, error) {
+	jsonBytes, err := json.Marshal(dao)
+	return string(jsonBytes), err
+}
+
+func NewAppliedConfigDAO(marshalled []byte) (AppliedConfigDAO, error) {
+	dao := &AppliedConfigDAO{}
+	err := json.Unmarshal(marshalled, dao)
+	if err != nil {
+		return AppliedConfigDAO{}, err
+	}
+	return *dao, nil
+}
@@ -0,0 +1,189 @@
+package store
+
+import (
+	"container/list"
+	"context"
+	"expvar"
+	"log"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	minWatchRetryDelay = 100 * time.Millisecond
+	maxWatchRetryDelay = 10 * time.Second
+)
+
+var cacheMetrics = expvar.NewMap("kuiper_config_cache")
+
+type cacheEntry struct {
+	key   string
+	value []byte
+}
+
+// lruCache is a size bounded cache of stored values kept coherent with etcd
+// by a watch on the cached key prefix. Every change the watch sees replaces or
+// drops the value of its key, so replicas pick up writes made through any of
+// them. Values are kept marshalled, so every read hands out a fresh copy.
+type lruCache struct {
+	name       string
+	maxEntries int
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	order      *list.List
+	// reads of keys that aren't cached yet, see startLoad
+	loads    map[string]uint64
+	lastLoad uint64
+}
+
+func newLRUCache(name string, maxEntries int) *lruCache {
+	return &lruCache{
+		name:       name,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+		loads:      make(map[string]uint64),
+	}
+}
+
+func (c *lruCache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		cacheMetrics.Add(c.name+"_misses", 1)
+		return nil, false
+	}
+	cacheMetrics.Add(c.name+"_hits", 1)
+	c.order.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).value, true
+}
+
+// startLoad must be called before reading a value that will be passed to
+// add. The value is only cached if its key doesn't change in the meantime,
+// so that a value read concurrently with a delete isn't cached.
+func (c *lruCache) startLoad(key string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastLoad++
+	c.loads[key] = c.lastLoad
+	return c.lastLoad
+}
+
+// add caches the value read by load, if it's still the latest read of the key.
+func (c *lruCache) add(key string, value []byte, load uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.loads[key] != load {
+		return
+	}
+	delete(c.loads, key)
+	c.put(key, value)
+}
+
+// abandon forgets a load whose value couldn't be read.
+func (c *lruCache) abandon(key string, load uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.loads[key] == load {
+		delete(c.loads, key)
+	}
+}
+
+// set caches a value seen by the watch, which is newer than any value being
+// read concurrently.
+func (c *lruCache) set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.loads, key)
+	c.put(key, value)
+}
+
+func (c *lruCache) put(key string, value []byte) {
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*cacheEntry).value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, value: value})
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+		cacheMetrics.Add(c.name+"_evictions", 1)
+	}
+	cacheMetrics.Set(c.name+"_size", sizeVar(c.order.Len()))
+}
+
+func (c *lruCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.loads, key)
+	if elem, ok := c.entries[key]; ok {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+	}
+	cacheMetrics.Set(c.name+"_size", sizeVar(c.order.Len()))
+}
+
+func (c *lruCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loads = make(map[string]uint64)
+	c.entries = make(map[string]*list.Element)
+	c.order.Init()
+	cacheMetrics.Set(c.name+"_size", sizeVar(0))
+}
+
+// watch applies every change under prefix to the cache until ctx is done. A
+// watch that fails is resumed from the last change it delivered, backing off
+// while etcd is unavailable, and the cache is only purged if that change can
+// no longer be resumed from.
+func (c *lruCache) watch(ctx context.Context, client *clientv3.Client, prefix string) {
+	var rev int64
+	retryDelay := minWatchRetryDelay
+	for ctx.Err() == nil {
+		opts := []clientv3.OpOption{clientv3.WithPrefix()}
+		if rev > 0 {
+			opts = append(opts, clientv3.WithRev(rev+1))
+		} else {
+			// events may have been missed while no watch was open
+			c.purge()
+		}
+		for resp := range client.Watch(clientv3.WithRequireLeader(ctx), prefix, opts...) {
+			if resp.CompactRevision > 0 {
+				log.Printf("%s cache watch compacted at revision %d", c.name, resp.CompactRevision)
+				rev = 0
+				break
+			}
+			if err := resp.Err(); err != nil {
+				log.Printf("%s cache watch failed: %s", c.name, err)
+				break
+			}
+			retryDelay = minWatchRetryDelay
+			for _, event := range resp.Events {
+				switch event.Type {
+				case clientv3.EventTypePut:
+					c.set(string(event.Kv.Key), event.Kv.Value)
+				case clientv3.EventTypeDelete:
+					c.invalidate(string(event.Kv.Key))
+				}
+				rev = event.Kv.ModRevision
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryDelay):
+		}
+		retryDelay = min(2*retryDelay, maxWatchRetryDelay)
+	}
+}
+
+func sizeVar(size int) *expvar.Int {
+	v := &expvar.Int{}
+	v.Set(int64(size))
+	return v
+}
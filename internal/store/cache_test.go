@@ -0,0 +1,140 @@
+package store
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLRUCacheLoads(t *testing.T) {
+	tests := []struct {
+		name string
+		// runs between starting the load of "a" and adding its value
+		during func(c *lruCache)
+		cached bool
+		value  string
+	}{
+		{
+			name:   "uncontended load",
+			during: func(c *lruCache) {},
+			cached: true,
+			value:  "loaded",
+		},
+		{
+			name: "deleted while loading",
+			during: func(c *lruCache) {
+				c.invalidate("a")
+			},
+			cached: false,
+		},
+		{
+			name: "watch saw a newer value",
+			during: func(c *lruCache) {
+				c.set("a", []byte("watched"))
+			},
+			cached: true,
+			value:  "watched",
+		},
+		{
+			name: "read again while loading",
+			during: func(c *lruCache) {
+				c.startLoad("a")
+			},
+			cached: false,
+		},
+		{
+			name: "purged while loading",
+			during: func(c *lruCache) {
+				c.purge()
+			},
+			cached: false,
+		},
+		{
+			name: "other key changed",
+			during: func(c *lruCache) {
+				c.invalidate("b")
+			},
+			cached: true,
+			value:  "loaded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newLRUCache("test", 10)
+			load := c.startLoad("a")
+			tt.during(c)
+			c.add("a", []byte("loaded"), load)
+
+			value, ok := c.get("a")
+			if ok != tt.cached {
+				t.Fatalf("cached = %t, want %t", ok, tt.cached)
+			}
+			if ok && string(value) != tt.value {
+				t.Errorf("value = %q, want %q", value, tt.value)
+			}
+		})
+	}
+}
+
+func TestLRUCacheEviction(t *testing.T) {
+	// ops are keys to put, prefixed with "get " for keys to read
+	tests := []struct {
+		name       string
+		maxEntries int
+		ops        []string
+		cached     []string
+		evicted    []string
+	}{
+		{
+			name:       "under capacity",
+			maxEntries: 3,
+			ops:        []string{"a", "b"},
+			cached:     []string{"a", "b"},
+		},
+		{
+			name:       "evicts least recently used",
+			maxEntries: 2,
+			ops:        []string{"a", "b", "c"},
+			cached:     []string{"b", "c"},
+			evicted:    []string{"a"},
+		},
+		{
+			name:       "reads refresh entries",
+			maxEntries: 2,
+			ops:        []string{"a", "b", "get a", "c"},
+			cached:     []string{"a", "c"},
+			evicted:    []string{"b"},
+		},
+		{
+			name:       "overwrites refresh entries",
+			maxEntries: 2,
+			ops:        []string{"a", "b", "a", "c"},
+			cached:     []string{"a", "c"},
+			evicted:    []string{"b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newLRUCache("test", tt.maxEntries)
+			for _, op := range tt.ops {
+				if key, ok := strings.CutPrefix(op, "get "); ok {
+					c.get(key)
+					continue
+				}
+				c.set(op, []byte(op))
+			}
+			if len(c.entries) != c.order.Len() || c.order.Len() > tt.maxEntries {
+				t.Errorf("cache holds %d entries in %d elements, max %d", len(c.entries), c.order.Len(), tt.maxEntries)
+			}
+			for _, key := range tt.cached {
+				if _, ok := c.get(key); !ok {
+					t.Errorf("%s was evicted", key)
+				}
+			}
+			for _, key := range tt.evicted {
+				if _, ok := c.get(key); ok {
+					t.Errorf("%s wasn't evicted", key)
+				}
+			}
+		})
+	}
+}
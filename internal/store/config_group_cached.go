@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"log"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type ConfigGroupCachedStore struct {
+	store domain.ConfigGroupStore
+	cache *lruCache
+}
+
+func NewConfigGroupCachedStore(ctx context.Context, client *clientv3.Client, store domain.ConfigGroupStore, maxEntries int) domain.ConfigGroupStore {
+	cache := newLRUCache("groups", maxEntries)
+	go cache.watch(ctx, client, "groups/")
+	return ConfigGroupCachedStore{
+		store: store,
+		cache: cache,
+	}
+}
+
+func (s ConfigGroupCachedStore) Put(ctx context.Context, config *domain.ConfigGroup) *domain.Error {
+	return s.store.Put(ctx, config)
+}
+
+func (s ConfigGroupCachedStore) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+	if domain.IsLinearizableRead(ctx) {
+		return s.store.Get(ctx, org, namespace, name, version)
+	}
+	key := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}.Key()
+	if value, ok := s.cache.get(key); ok {
+		dao, err := NewConfigGroupDAO(value)
+		if err == nil {
+			return dao.config(), nil
+		}
+		log.Println(err)
+	}
+	load := s.cache.startLoad(key)
+	config, err := s.store.Get(ctx, org, namespace, name, version)
+	if err != nil {
+		s.cache.abandon(key, load)
+		return nil, err
+	}
+	value, marshalErr := newConfigGroupDAO(config).Marshal()
+	if marshalErr != nil {
+		s.cache.abandon(key, load)
+		return config, nil
+	}
+	s.cache.add(key, []byte(value), load)
+	return config, nil
+}
+
+func (s ConfigGroupCachedStore) List(ctx context.Context, org domain.Org, namespace string) ([]*domain.ConfigGroup, *domain.Error) {
+	return s.store.List(ctx, org, namespace)
+}
+
+func (s ConfigGroupCachedStore) Search(ctx context.Context, org domain.Org, pageToken string, limit int, match func(config *domain.ConfigGroup) bool) ([]*domain.ConfigGroup, string, *domain.Error) {
+	return s.store.Search(ctx, org, pageToken, limit, match)
+}
+
+func (s ConfigGroupCachedStore) Delete(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+	s.cache.invalidate(ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}.Key())
+	return s.store.Delete(ctx, org, namespace, name, version)
+}
@@ -21,24 +21,7 @@ func NewConfigGroupEtcdStore(client *clientv3.Client) domain.ConfigGroupStore {
 }
 
 func (s ConfigGroupEtcdStore) Put(ctx context.Context, config *domain.ConfigGroup) *domain.Error {
-	dao := ConfigGroupDAO{
-		Org:       string(config.Org()),
-		Namespace: config.Namespace(),
-		Name:      config.Name(),
-		Version:   config.Version(),
-		CreatedAt: config.CreatedAtUnixSec(),
-	}
-	for _, ps := range config.ParamSets() {
-		psDao := struct {
-			Name     string
-			ParamSet map[string]string
-		}{
-			Name:     ps.Name(),
-			ParamSet: ps.ParamSet(),
-		}
-		dao.ParamsSets = append(dao.ParamsSets, psDao)
-	}
-
+	dao := newConfigGroupDAO(config)
 	key := dao.Key()
 	value, err := dao.Marshal()
 	if err != nil {
@@ -75,13 +58,7 @@ func (s ConfigGroupEtcdStore) Get(ctx context.Context, org domain.Org, namespace
 	if err != nil {
 		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
 	}
-
-	paramSets := make([]domain.NamedParamSet, 0, len(dao.ParamsSets))
-	for _, psDao := range dao.ParamsSets {
-		paramSets = append(paramSets, *domain.NewParamSet(psDao.Name, psDao.ParamSet))
-	}
-
-	return domain.InitConfigGroup(domain.Org(dao.Org), dao.Namespace, dao.Name, dao.Version, dao.CreatedAt, paramSets), nil
+	return dao.config(), nil
 }
 
 func (s ConfigGroupEtcdStore) List(ctx context.Context, org domain.Org, namespace string) ([]*domain.ConfigGroup, *domain.Error) {
@@ -89,6 +66,23 @@ func (s ConfigGroupEtcdStore) List(ctx context.Context, org domain.Org, namespac
 		Org:       string(org),
 		Namespace: namespace,
 	}.KeyPrefixAll()
+	return s.listByPrefix(ctx, key)
+}
+
+func (s ConfigGroupEtcdStore) Search(ctx context.Context, org domain.Org, pageToken string, limit int, match func(config *domain.ConfigGroup) bool) ([]*domain.ConfigGroup, string, *domain.Error) {
+	prefix := ConfigGroupDAO{
+		Org: string(org),
+	}.KeyPrefixOrg()
+	return scanPrefix(ctx, s.client, prefix, pageToken, limit, func(value []byte) (*domain.ConfigGroup, error) {
+		dao, err := NewConfigGroupDAO(value)
+		if err != nil {
+			return nil, err
+		}
+		return dao.config(), nil
+	}, match)
+}
+
+func (s ConfigGroupEtcdStore) listByPrefix(ctx context.Context, key string) ([]*domain.ConfigGroup, *domain.Error) {
 	resp, err := s.client.KV.Get(ctx, key, clientv3.WithPrefix())
 	if err != nil {
 		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
@@ -101,13 +95,7 @@ func (s ConfigGroupEtcdStore) List(ctx context.Context, org domain.Org, namespac
 			log.Println(err)
 			continue
 		}
-
-		paramSets := make([]domain.NamedParamSet, 0, len(dao.ParamsSets))
-		for _, psDao := range dao.ParamsSets {
-			paramSets = append(paramSets, *domain.NewParamSet(psDao.Name, psDao.ParamSet))
-		}
-
-		configs = append(configs, domain.InitConfigGroup(domain.Org(dao.Org), dao.Namespace, dao.Name, dao.Version, dao.CreatedAt, paramSets))
+		configs = append(configs, dao.config())
 	}
 
 	return configs, nil
@@ -133,13 +121,7 @@ func (s ConfigGroupEtcdStore) Delete(ctx context.Context, org domain.Org, namesp
 	if err != nil {
 		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
 	}
-
-	paramSets := make([]domain.NamedParamSet, 0, len(dao.ParamsSets))
-	for _, psDao := range dao.ParamsSets {
-		paramSets = append(paramSets, *domain.NewParamSet(psDao.Name, psDao.ParamSet))
-	}
-
-	return domain.InitConfigGroup(domain.Org(dao.Org), dao.Namespace, dao.Name, dao.Version, dao.CreatedAt, paramSets), nil
+	return dao.config(), nil
 }
 
 type ConfigGroupDAO struct {
@@ -152,14 +134,51 @@ type ConfigGroupDAO struct {
 		Name     string
 		ParamSet map[string]string
 	}
+	Labels map[string]string
+}
+
+func newConfigGroupDAO(config *domain.ConfigGroup) ConfigGroupDAO {
+	dao := ConfigGroupDAO{
+		Org:       string(config.Org()),
+		Namespace: config.Namespace(),
+		Name:      config.Name(),
+		Version:   config.Version(),
+		CreatedAt: config.CreatedAtUnixSec(),
+		Labels:    config.Labels(),
+	}
+	for _, ps := range config.ParamSets() {
+		psDao := struct {
+			Name     string
+			ParamSet map[string]string
+		}{
+			Name:     ps.Name(),
+			ParamSet: ps.ParamSet(),
+		}
+		dao.ParamsSets = append(dao.ParamsSets, psDao)
+	}
+	return dao
+}
+
+func (dao ConfigGroupDAO) config() *domain.ConfigGroup {
+	paramSets := make([]domain.NamedParamSet, 0, len(dao.ParamsSets))
+	for _, psDao := range dao.ParamsSets {
+		paramSets = append(paramSets, *domain.NewParamSet(psDao.Name, psDao.ParamSet))
+	}
+	config := domain.InitConfigGroup(domain.Org(dao.Org), dao.Namespace, dao.Name, dao.Version, dao.CreatedAt, paramSets)
+	config.SetLabels(dao.Labels)
+	return config
 }
 
 func (dao ConfigGroupDAO) Key() string {
-	return fmt.Sprintf("groups/%s/%s/%s/%s", dao.Org, dao.Namespace, dao.Name, dao.Version)
+	return key("groups", dao.Org, dao.Namespace, dao.Name, dao.Version)
 }
 
 func (dao ConfigGroupDAO) KeyPrefixAll() string {
-	return fmt.Sprintf("groups/%s/%s/", dao.Org, dao.Namespace)
+	return keyPrefix("groups", dao.Org, dao.Namespace)
+}
+
+func (dao ConfigGroupDAO) KeyPrefixOrg() string {
+	return keyPrefix("groups", dao.Org)
 }
 
 func (dao ConfigGroupDAO) Marshal() (string, error) {
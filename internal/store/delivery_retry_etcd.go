@@ -0,0 +1,125 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type DeliveryRetryEtcdStore struct {
+	client *clientv3.Client
+}
+
+func NewDeliveryRetryEtcdStore(client *clientv3.Client) domain.DeliveryRetryStore {
+	return DeliveryRetryEtcdStore{
+		client: client,
+	}
+}
+
+func (s DeliveryRetryEtcdStore) Put(ctx context.Context, retry domain.DeliveryRetry) *domain.Error {
+	dao := DeliveryRetryDAO{
+		Org:           string(retry.Config.Org),
+		Namespace:     retry.Config.Namespace,
+		Name:          retry.Config.Name,
+		Version:       retry.Config.Version,
+		Type:          retry.Config.Type,
+		TaskId:        retry.TaskId,
+		Node:          string(retry.Node),
+		Strategy:      retry.Strategy,
+		Attempts:      retry.Attempts,
+		NextAttemptAt: retry.NextAttemptAt,
+		LastError:     retry.LastError,
+	}
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	_, err = s.client.KV.Put(ctx, dao.Key(), value)
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return nil
+}
+
+func (s DeliveryRetryEtcdStore) ListDue(ctx context.Context, now time.Time) ([]domain.DeliveryRetry, *domain.Error) {
+	resp, err := s.client.KV.Get(ctx, keyPrefix("retries"), clientv3.WithPrefix())
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+
+	retries := make([]domain.DeliveryRetry, 0)
+	for _, kv := range resp.Kvs {
+		dao, err := NewDeliveryRetryDAO(kv.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		retry := domain.DeliveryRetry{
+			Config: domain.ConfigRef{
+				Org:       domain.Org(dao.Org),
+				Namespace: dao.Namespace,
+				Name:      dao.Name,
+				Version:   dao.Version,
+				Type:      dao.Type,
+			},
+			TaskId:        dao.TaskId,
+			Node:          domain.Node(dao.Node),
+			Strategy:      dao.Strategy,
+			Attempts:      dao.Attempts,
+			NextAttemptAt: dao.NextAttemptAt,
+			LastError:     dao.LastError,
+		}
+		if retry.Due(now) {
+			retries = append(retries, retry)
+		}
+	}
+	return retries, nil
+}
+
+func (s DeliveryRetryEtcdStore) Delete(ctx context.Context, org domain.Org, taskId string) *domain.Error {
+	key := DeliveryRetryDAO{
+		Org:    string(org),
+		TaskId: taskId,
+	}.Key()
+	_, err := s.client.KV.Delete(ctx, key)
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return nil
+}
+
+type DeliveryRetryDAO struct {
+	Org           string
+	Namespace     string
+	Name          string
+	Version       string
+	Type          string
+	TaskId        string
+	Node          string
+	Strategy      string
+	Attempts      int
+	NextAttemptAt int64
+	LastError     string
+}
+
+func (dao DeliveryRetryDAO) Key() string {
+	return key("retries", dao.Org, dao.TaskId)
+}
+
+func (dao DeliveryRetryDAO) Marshal() (string, error) {
+	jsonBytes, err := json.Marshal(dao)
+	return string(jsonBytes), err
+}
+
+func NewDeliveryRetryDAO(marshalled []byte) (DeliveryRetryDAO, error) {
+	dao := &DeliveryRetryDAO{}
+	err := json.Unmarshal(marshalled, dao)
+	if err != nil {
+		return DeliveryRetryDAO{}, err
+	}
+	return *dao, nil
+}
@@ -0,0 +1,75 @@
+package store
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// key escapes every segment so that identifiers containing slashes or other
+// special characters can't spill into neighbouring parts of the keyspace.
+func key(segments ...string) string {
+	escaped := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		escaped = append(escaped, url.PathEscape(segment))
+	}
+	return strings.Join(escaped, "/")
+}
+
+func keyPrefix(segments ...string) string {
+	return key(segments...) + "/"
+}
+
+const scanBatchSize = 500
+
+// scanPrefix reads the keys under prefix in batches, starting after the key
+// pageToken points at, until it has collected limit values accepted by match.
+// The returned token points at the last key read and is empty once the whole
+// prefix has been read. Batches are read at the revision of the first one, so
+// a scan sees a consistent snapshot.
+func scanPrefix[T any](ctx context.Context, client *clientv3.Client, prefix, pageToken string, limit int, parse func(value []byte) (T, error), match func(item T) bool) ([]T, string, *domain.Error) {
+	from := prefix
+	if pageToken != "" {
+		from = prefix + pageToken + "\x00"
+	}
+	end := clientv3.GetPrefixRangeEnd(prefix)
+	items := make([]T, 0, limit)
+	var rev int64
+	for {
+		opts := []clientv3.OpOption{clientv3.WithRange(end), clientv3.WithLimit(scanBatchSize)}
+		if rev > 0 {
+			opts = append(opts, clientv3.WithRev(rev))
+		}
+		resp, err := client.KV.Get(ctx, from, opts...)
+		if err != nil {
+			return nil, "", domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		rev = resp.Header.Revision
+		for i, kv := range resp.Kvs {
+			item, err := parse(kv.Value)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			if !match(item) {
+				continue
+			}
+			items = append(items, item)
+			if len(items) < limit {
+				continue
+			}
+			if i == len(resp.Kvs)-1 && !resp.More {
+				return items, "", nil
+			}
+			return items, strings.TrimPrefix(string(kv.Key), prefix), nil
+		}
+		if !resp.More || len(resp.Kvs) == 0 {
+			return items, "", nil
+		}
+		from = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
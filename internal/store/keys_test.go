@@ -0,0 +1,87 @@
+package store
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []string
+		want     string
+	}{
+		{
+			name:     "plain segments",
+			segments: []string{"standalone", "org", "ns", "name", "v1"},
+			want:     "standalone/org/ns/name/v1",
+		},
+		{
+			name:     "slash in a segment",
+			segments: []string{"standalone", "org", "ns", "a/b", "v1"},
+			want:     "standalone/org/ns/a%2Fb/v1",
+		},
+		{
+			name:     "percent is escaped so escaped names don't collide",
+			segments: []string{"standalone", "org", "ns", "a%2Fb", "v1"},
+			want:     "standalone/org/ns/a%252Fb/v1",
+		},
+		{
+			name:     "spaces and unicode",
+			segments: []string{"groups", "org", "my ns", "čvor"},
+			want:     "groups/org/my%20ns/%C4%8Dvor",
+		},
+		{
+			name:     "empty segment keeps its place",
+			segments: []string{"nodes", "org", "", "id"},
+			want:     "nodes/org//id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := key(tt.segments...)
+			if got != tt.want {
+				t.Errorf("key() = %q, want %q", got, tt.want)
+			}
+			if parts := strings.Split(got, "/"); len(parts) != len(tt.segments) {
+				t.Errorf("key() has %d segments, want %d", len(parts), len(tt.segments))
+			}
+		})
+	}
+}
+
+func TestKeyPrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   []string
+		key      []string
+		included bool
+	}{
+		{
+			name:     "key under the prefix",
+			prefix:   []string{"standalone", "org", "ns"},
+			key:      []string{"standalone", "org", "ns", "name", "v1"},
+			included: true,
+		},
+		{
+			name:     "namespace sharing the prefix's leading characters",
+			prefix:   []string{"standalone", "org", "ns"},
+			key:      []string{"standalone", "org", "ns2", "name", "v1"},
+			included: false,
+		},
+		{
+			name:     "name with a slash can't reach into another namespace",
+			prefix:   []string{"standalone", "org", "ns", "a"},
+			key:      []string{"standalone", "org", "ns", "a/b", "v1"},
+			included: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			included := strings.HasPrefix(key(tt.key...), keyPrefix(tt.prefix...))
+			if included != tt.included {
+				t.Errorf("%q under %q = %t, want %t", key(tt.key...), keyPrefix(tt.prefix...), included, tt.included)
+			}
+		})
+	}
+}
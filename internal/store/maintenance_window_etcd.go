@@ -0,0 +1,123 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type MaintenanceWindowEtcdStore struct {
+	client *clientv3.Client
+}
+
+func NewMaintenanceWindowEtcdStore(client *clientv3.Client) domain.MaintenanceWindowStore {
+	return MaintenanceWindowEtcdStore{
+		client: client,
+	}
+}
+
+func (s MaintenanceWindowEtcdStore) Put(ctx context.Context, window domain.MaintenanceWindow) *domain.Error {
+	dao := MaintenanceWindowDAO{
+		Org:       string(window.Org),
+		Namespace: window.Namespace,
+		Name:      window.Name,
+		Schedule:  window.Schedule,
+		Duration:  window.Duration,
+		CreatedAt: window.CreatedAt,
+	}
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	_, err = s.client.KV.Put(ctx, dao.Key(), value)
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return nil
+}
+
+func (s MaintenanceWindowEtcdStore) Delete(ctx context.Context, org domain.Org, namespace, name string) (*domain.MaintenanceWindow, *domain.Error) {
+	key := MaintenanceWindowDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+	}.Key()
+	resp, err := s.client.KV.Delete(ctx, key, clientv3.WithPrevKV())
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if len(resp.PrevKvs) == 0 {
+		return nil, domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("maintenance window (name=%s) not found", name))
+	}
+	dao, err := NewMaintenanceWindowDAO(resp.PrevKvs[0].Value)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	window := dao.toDomain()
+	return &window, nil
+}
+
+func (s MaintenanceWindowEtcdStore) ListByOrg(ctx context.Context, org domain.Org) ([]domain.MaintenanceWindow, *domain.Error) {
+	resp, err := s.client.KV.Get(ctx, MaintenanceWindowDAO{Org: string(org)}.KeyPrefixByOrg(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	windows := make([]domain.MaintenanceWindow, 0, resp.Count)
+	for _, kv := range resp.Kvs {
+		dao, err := NewMaintenanceWindowDAO(kv.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		windows = append(windows, dao.toDomain())
+	}
+	return windows, nil
+}
+
+type MaintenanceWindowDAO struct {
+	Org       string
+	Namespace string
+	Name      string
+	Schedule  string
+	Duration  time.Duration
+	CreatedAt int64
+}
+
+func (dao MaintenanceWindowDAO) toDomain() domain.MaintenanceWindow {
+	return domain.MaintenanceWindow{
+		Org:       domain.Org(dao.Org),
+		Namespace: dao.Namespace,
+		Name:      dao.Name,
+		Schedule:  dao.Schedule,
+		Duration:  dao.Duration,
+		CreatedAt: dao.CreatedAt,
+	}
+}
+
+// Key keeps org-wide windows, which have no namespace, under an empty segment.
+func (dao MaintenanceWindowDAO) Key() string {
+	return key("windows", dao.Org, dao.Namespace, dao.Name)
+}
+
+func (dao MaintenanceWindowDAO) KeyPrefixByOrg() string {
+	return keyPrefix("windows", dao.Org)
+}
+
+func (dao MaintenanceWindowDAO) Marshal() (string, error) {
+	jsonBytes, err := json.Marshal(dao)
+	return string(jsonBytes), err
+}
+
+func NewMaintenanceWindowDAO(marshalled []byte) (MaintenanceWindowDAO, error) {
+	dao := &MaintenanceWindowDAO{}
+	err := json.Unmarshal(marshalled, dao)
+	if err != nil {
+		return MaintenanceWindowDAO{}, err
+	}
+	return *dao, nil
+}
@@ -0,0 +1,186 @@
+package store
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// the first version didn't index tasks whose keys needed no escaping, so it
+// has to run again where it already completed
+const keyEncodingMigrationKey = "migrations/key-encoding-v2"
+
+// MigrateKeyEncoding moves configs and placement tasks stored under raw,
+// unescaped keys to their escaped keys and indexes every task by its node. It
+// is safe to run concurrently from multiple replicas and is skipped once it
+// has completed.
+func MigrateKeyEncoding(ctx context.Context, client *clientv3.Client) error {
+	resp, err := client.KV.Get(ctx, keyEncodingMigrationKey)
+	if err != nil {
+		return err
+	}
+	if resp.Count > 0 {
+		return nil
+	}
+
+	err = migratePrefix(ctx, client, "standalone/", func(kv *mvccpb.KeyValue) ([]string, string, bool) {
+		dao, err := NewStandaloneConfigDAO(kv.Value)
+		if err != nil {
+			log.Println(err)
+			return nil, "", false
+		}
+		return []string{dao.Key()}, string(kv.Value), true
+	})
+	if err != nil {
+		return err
+	}
+	err = migratePrefix(ctx, client, "groups/", func(kv *mvccpb.KeyValue) ([]string, string, bool) {
+		dao, err := NewConfigGroupDAO(kv.Value)
+		if err != nil {
+			log.Println(err)
+			return nil, "", false
+		}
+		return []string{dao.Key()}, string(kv.Value), true
+	})
+	if err != nil {
+		return err
+	}
+	// index entries are rebuilt from the tasks, so the ones under raw keys
+	// are only dropped
+	err = migratePrefix(ctx, client, "nodes/", func(kv *mvccpb.KeyValue) ([]string, string, bool) {
+		dao, err := NewPlacementTaskDAO(kv.Value)
+		if err != nil {
+			log.Println(err)
+			return nil, "", false
+		}
+		if dao.NodeKey(dao.Type) == string(kv.Key) {
+			return nil, "", false
+		}
+		return nil, "", true
+	})
+	if err != nil {
+		return err
+	}
+	err = migratePrefix(ctx, client, "placements/", func(kv *mvccpb.KeyValue) ([]string, string, bool) {
+		// keeps the task's order once it's stored under a new key
+		dao, err := placementTaskDAO(kv)
+		if err != nil {
+			log.Println(err)
+			return nil, "", false
+		}
+		// tasks placed before the node index existed don't record their type
+		dao.Type = strings.Split(string(kv.Key), "/")[1]
+		value, err := dao.Marshal()
+		if err != nil {
+			log.Println(err)
+			return nil, "", false
+		}
+		return []string{dao.Key(dao.Type), dao.NodeKey(dao.Type)}, value, true
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.KV.Put(ctx, keyEncodingMigrationKey, "done")
+	return err
+}
+
+const deadlineIndexMigrationKey = "migrations/deadline-index"
+
+// IndexDeadlines adds the pending tasks placed before the deadline index
+// existed to it. Entries of tasks resolved while it runs are dropped by the
+// reaper, so it needs no transactions.
+func IndexDeadlines(ctx context.Context, client *clientv3.Client) error {
+	resp, err := client.KV.Get(ctx, deadlineIndexMigrationKey)
+	if err != nil {
+		return err
+	}
+	if resp.Count > 0 {
+		return nil
+	}
+
+	resp, err = client.KV.Get(ctx, "placements/", clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+	for _, kv := range resp.Kvs {
+		dao, err := NewPlacementTaskDAO(kv.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		deadlineKey, ok := dao.DeadlineKey()
+		if !ok {
+			continue
+		}
+		if _, err := client.KV.Put(ctx, deadlineKey, string(kv.Key)); err != nil {
+			return err
+		}
+	}
+
+	_, err = client.KV.Put(ctx, deadlineIndexMigrationKey, "done")
+	return err
+}
+
+// migratePrefix stores the value returned by migrate under each of the
+// returned keys, deleting the old key unless it's one of them.
+func migratePrefix(ctx context.Context, client *clientv3.Client, prefix string, migrate func(kv *mvccpb.KeyValue) ([]string, string, bool)) error {
+	resp, err := client.KV.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+	for _, kv := range resp.Kvs {
+		if err := migrateKey(ctx, client, kv, migrate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateKey only moves the key if it wasn't modified since it was read. If
+// it was, e.g. because another replica migrated it first or its task was
+// updated, it's read and migrated again.
+func migrateKey(ctx context.Context, client *clientv3.Client, kv *mvccpb.KeyValue, migrate func(kv *mvccpb.KeyValue) ([]string, string, bool)) error {
+	for {
+		oldKey := string(kv.Key)
+		keys, value, ok := migrate(kv)
+		if !ok {
+			return nil
+		}
+		ops := make([]clientv3.Op, 0, len(keys)+1)
+		for _, newKey := range keys {
+			if newKey != oldKey || value != string(kv.Value) {
+				ops = append(ops, clientv3.OpPut(newKey, value))
+			}
+		}
+		if !slices.Contains(keys, oldKey) {
+			ops = append(ops, clientv3.OpDelete(oldKey))
+		}
+		if len(ops) == 0 {
+			return nil
+		}
+		txnResp, err := client.KV.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(oldKey), "=", kv.ModRevision)).
+			Then(ops...).
+			Commit()
+		if err != nil {
+			return err
+		}
+		if txnResp.Succeeded {
+			log.Printf("migrated key %s", oldKey)
+			return nil
+		}
+		resp, err := client.KV.Get(ctx, oldKey)
+		if err != nil {
+			return err
+		}
+		if len(resp.Kvs) == 0 {
+			return nil
+		}
+		kv = resp.Kvs[0]
+	}
+}
@@ -0,0 +1,207 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type PersistentPlacementEtcdStore struct {
+	client *clientv3.Client
+}
+
+func NewPersistentPlacementEtcdStore(client *clientv3.Client) domain.PersistentPlacementStore {
+	return PersistentPlacementEtcdStore{
+		client: client,
+	}
+}
+
+func (s PersistentPlacementEtcdStore) Put(ctx context.Context, placement domain.PersistentPlacement) *domain.Error {
+	dao := PersistentPlacementDAO{
+		Org:             string(placement.Config.Org),
+		Namespace:       placement.Config.Namespace,
+		Name:            placement.Config.Name,
+		Version:         placement.Config.Version,
+		Type:            placement.Config.Type,
+		Strategy:        placement.Strategy,
+		Timeout:         placement.Timeout,
+		RemoveUnmatched: placement.RemoveUnmatched,
+		CreatedAt:       placement.CreatedAt,
+		Subject:         placement.Identity.Subject,
+		Permissions:     placement.Identity.Permissions,
+		ExpiresAt:       placement.Identity.ExpiresAt,
+	}
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	_, err = s.client.KV.Put(ctx, dao.Key(), value)
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return nil
+}
+
+func (s PersistentPlacementEtcdStore) Delete(ctx context.Context, config domain.ConfigRef) (*domain.PersistentPlacement, *domain.Error) {
+	key := PersistentPlacementDAO{
+		Org:       string(config.Org),
+		Namespace: config.Namespace,
+		Name:      config.Name,
+		Type:      config.Type,
+	}.Key()
+	resp, err := s.client.KV.Delete(ctx, key, clientv3.WithPrevKV())
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if len(resp.PrevKvs) == 0 {
+		return nil, domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("persistent placement of %s/%s not found", config.Namespace, config.Name))
+	}
+	dao, err := NewPersistentPlacementDAO(resp.PrevKvs[0].Value)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	placement := dao.toDomain()
+	return &placement, nil
+}
+
+func (s PersistentPlacementEtcdStore) DeleteVersion(ctx context.Context, config domain.ConfigRef) (*domain.PersistentPlacement, *domain.Error) {
+	return s.deleteIf(ctx, config, func(dao PersistentPlacementDAO) bool {
+		return dao.Version == config.Version
+	})
+}
+
+func (s PersistentPlacementEtcdStore) DeleteOtherVersion(ctx context.Context, config domain.ConfigRef) (*domain.PersistentPlacement, *domain.Error) {
+	return s.deleteIf(ctx, config, func(dao PersistentPlacementDAO) bool {
+		return dao.Version != config.Version
+	})
+}
+
+// deleteIf only deletes the placement if it wasn't modified since it was read,
+// as it may have been replaced with one of another version in the meantime.
+func (s PersistentPlacementEtcdStore) deleteIf(ctx context.Context, config domain.ConfigRef, matches func(dao PersistentPlacementDAO) bool) (*domain.PersistentPlacement, *domain.Error) {
+	key := PersistentPlacementDAO{
+		Org:       string(config.Org),
+		Namespace: config.Namespace,
+		Name:      config.Name,
+		Type:      config.Type,
+	}.Key()
+	for {
+		resp, err := s.client.KV.Get(ctx, key)
+		if err != nil {
+			return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if len(resp.Kvs) == 0 {
+			return nil, nil
+		}
+		dao, err := NewPersistentPlacementDAO(resp.Kvs[0].Value)
+		if err != nil {
+			return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+		}
+		if !matches(dao) {
+			return nil, nil
+		}
+		txnResp, err := s.client.KV.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(key), "=", resp.Kvs[0].ModRevision)).
+			Then(clientv3.OpDelete(key)).
+			Commit()
+		if err != nil {
+			return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if txnResp.Succeeded {
+			placement := dao.toDomain()
+			return &placement, nil
+		}
+	}
+}
+
+func (s PersistentPlacementEtcdStore) List(ctx context.Context) ([]domain.PersistentPlacement, *domain.Error) {
+	return s.list(ctx, keyPrefix("persistent"))
+}
+
+func (s PersistentPlacementEtcdStore) ListByOrg(ctx context.Context, org domain.Org) ([]domain.PersistentPlacement, *domain.Error) {
+	return s.list(ctx, PersistentPlacementDAO{Org: string(org)}.KeyPrefixByOrg())
+}
+
+func (s PersistentPlacementEtcdStore) list(ctx context.Context, prefix string) ([]domain.PersistentPlacement, *domain.Error) {
+	resp, err := s.client.KV.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	placements := make([]domain.PersistentPlacement, 0, resp.Count)
+	for _, kv := range resp.Kvs {
+		dao, err := NewPersistentPlacementDAO(kv.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		placements = append(placements, dao.toDomain())
+	}
+	return placements, nil
+}
+
+type PersistentPlacementDAO struct {
+	Org             string
+	Namespace       string
+	Name            string
+	Version         string
+	Type            string
+	Strategy        []byte
+	Timeout         time.Duration
+	RemoveUnmatched bool
+	CreatedAt       int64
+	// permissions of the identity the placement is reconciled as
+	Subject     string
+	Permissions []string
+	ExpiresAt   int64
+}
+
+func (dao PersistentPlacementDAO) toDomain() domain.PersistentPlacement {
+	return domain.PersistentPlacement{
+		Config: domain.ConfigRef{
+			Org:       domain.Org(dao.Org),
+			Namespace: dao.Namespace,
+			Name:      dao.Name,
+			Version:   dao.Version,
+			Type:      dao.Type,
+		},
+		Strategy:        dao.Strategy,
+		Timeout:         dao.Timeout,
+		RemoveUnmatched: dao.RemoveUnmatched,
+		CreatedAt:       dao.CreatedAt,
+		Identity: domain.ServiceIdentity{
+			Org:         domain.Org(dao.Org),
+			Subject:     dao.Subject,
+			Permissions: dao.Permissions,
+			ExpiresAt:   dao.ExpiresAt,
+		},
+	}
+}
+
+// the version is left out of the key so that a config has a single
+// persistent placement
+func (dao PersistentPlacementDAO) Key() string {
+	return key("persistent", dao.Org, dao.Type, dao.Namespace, dao.Name)
+}
+
+func (dao PersistentPlacementDAO) KeyPrefixByOrg() string {
+	return keyPrefix("persistent", dao.Org)
+}
+
+func (dao PersistentPlacementDAO) Marshal() (string, error) {
+	jsonBytes, err := json.Marshal(dao)
+	return string(jsonBytes), err
+}
+
+func NewPersistentPlacementDAO(marshalled []byte) (PersistentPlacementDAO, error) {
+	dao := &PersistentPlacementDAO{}
+	err := json.Unmarshal(marshalled, dao)
+	if err != nil {
+		return PersistentPlacementDAO{}, err
+	}
+	return *dao, nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/c12s/kuiper/internal/domain"
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
@@ -23,15 +24,23 @@ func NewPlacementEtcdStore(client *clientv3.Client) domain.PlacementStore {
 
 func (s PlacementEtcdStore) Place(ctx context.Context, config domain.Config, req *domain.PlacementTask) *domain.Error {
 	dao := PlacementTaskDAO{
-		Id:         req.Id(),
-		Org:        string(config.Org()),
-		Namespace:  config.Namespace(),
-		Name:       config.Name(),
-		Version:    config.Version(),
-		Node:       string(req.Node()),
-		Status:     req.Status(),
-		AcceptedAt: req.AcceptedAtUnixSec(),
-		ResolvedAt: req.ResolvedAtUnixSec(),
+		Id:                req.Id(),
+		Org:               string(config.Org()),
+		Namespace:         config.Namespace(),
+		Name:              config.Name(),
+		Version:           config.Version(),
+		Type:              config.Type(),
+		Node:              string(req.Node()),
+		Status:            req.Status(),
+		AcceptedAt:        req.AcceptedAtUnixSec(),
+		ResolvedAt:        req.ResolvedAtUnixSec(),
+		RollbackOf:        req.RollbackOf(),
+		RollbackOfVersion: req.RollbackOfVersion(),
+		Deadline:          req.Deadline(),
+		Attempts:          req.Attempts(),
+		Reason:            req.Reason(),
+		Seed:              req.Seed(),
+		PlacementId:       req.PlacementId(),
 	}
 
 	key := dao.Key(config.Type())
@@ -40,7 +49,12 @@ func (s PlacementEtcdStore) Place(ctx context.Context, config domain.Config, req
 		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
 	}
 
-	_, err = s.client.KV.Put(ctx, key, value)
+	ops := []clientv3.Op{clientv3.OpPut(key, value), clientv3.OpPut(dao.NodeKey(config.Type()), value)}
+	// updates only move the index entry, so it has to exist from the start
+	if deadlineKey, ok := dao.DeadlineKey(); ok {
+		ops = append(ops, clientv3.OpPut(deadlineKey, key))
+	}
+	_, err = s.client.KV.Txn(ctx).Then(ops...).Commit()
 	if err != nil {
 		return domain.NewError(domain.ErrTypeDb, err.Error())
 	}
@@ -61,18 +75,181 @@ func (s PlacementEtcdStore) ListByConfig(ctx context.Context, org domain.Org, na
 
 	reqs := make([]domain.PlacementTask, 0, resp.Count)
 	for _, kv := range resp.Kvs {
-		dao, err := NewPlacementTaskDAO(kv.Value)
+		dao, err := placementTaskDAO(kv)
 		if err != nil {
 			log.Println(err)
 			continue
 		}
-		reqs = append(reqs, *domain.NewPlacementTask(dao.Id, domain.Node(dao.Node), dao.Status, dao.AcceptedAt, dao.ResolvedAt))
+		reqs = append(reqs, *dao.task())
 	}
 
 	return reqs, nil
 }
 
-func (s PlacementEtcdStore) UpdateStatus(ctx context.Context, org domain.Org, namespace, name string, version string, configType string, taskId string, status domain.PlacementTaskStatus) *domain.Error {
+func (s PlacementEtcdStore) Watch(ctx context.Context, config domain.ConfigRef) ([]domain.PlacementTask, <-chan domain.PlacementTask, *domain.Error) {
+	prefix := PlacementTaskDAO{
+		Org:       string(config.Org),
+		Namespace: config.Namespace,
+		Name:      config.Name,
+		Version:   config.Version,
+	}.KeyPrefixByConfig(config.Type)
+	resp, err := s.client.KV.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	tasks := make([]domain.PlacementTask, 0, resp.Count)
+	for _, kv := range resp.Kvs {
+		dao, err := placementTaskDAO(kv)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		tasks = append(tasks, *dao.task())
+	}
+
+	// start right after the read so no change is missed in between
+	watchChan := s.client.Watch(clientv3.WithRequireLeader(ctx), prefix, clientv3.WithPrefix(), clientv3.WithRev(resp.Header.Revision+1))
+	changes := make(chan domain.PlacementTask)
+	go func() {
+		defer close(changes)
+		for watchResp := range watchChan {
+			if err := watchResp.Err(); err != nil {
+				log.Printf("placement watch failed: %s", err)
+				return
+			}
+			for _, event := range watchResp.Events {
+				if event.Type != clientv3.EventTypePut {
+					continue
+				}
+				dao, err := placementTaskDAO(event.Kv)
+				if err != nil {
+					log.Println(err)
+					continue
+				}
+				select {
+				case changes <- *dao.task():
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return tasks, changes, nil
+}
+
+func (s PlacementEtcdStore) ListByNode(ctx context.Context, org domain.Org, node domain.Node) ([]domain.NodePlacement, *domain.Error) {
+	key := PlacementTaskDAO{
+		Org:  string(org),
+		Node: string(node),
+	}.KeyPrefixByNode()
+	return s.listNodePlacements(ctx, key)
+}
+
+func (s PlacementEtcdStore) ListByOrg(ctx context.Context, org domain.Org) ([]domain.NodePlacement, *domain.Error) {
+	key := PlacementTaskDAO{
+		Org: string(org),
+	}.KeyPrefixByOrg()
+	return s.listNodePlacements(ctx, key)
+}
+
+func (s PlacementEtcdStore) Get(ctx context.Context, config domain.ConfigRef, taskId string) (*domain.PlacementTask, *domain.Error) {
+	resp, err := s.client.KV.Get(ctx, taskKey(config, taskId))
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("task (id=%s) not found", taskId))
+	}
+	dao, err := placementTaskDAO(resp.Kvs[0])
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	return dao.task(), nil
+}
+
+// ListExpired reads the tasks indexed under deadlines up to now. Index entries
+// of tasks that were resolved or deleted in the meantime are dropped.
+func (s PlacementEtcdStore) ListExpired(ctx context.Context, now time.Time) ([]domain.NodePlacement, *domain.Error) {
+	resp, err := s.client.KV.Get(ctx, keyPrefix("deadlines"), clientv3.WithRange(deadlinePrefix(now.Unix()+1)))
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	expired := make([]domain.NodePlacement, 0)
+	for _, entry := range resp.Kvs {
+		taskResp, err := s.client.KV.Get(ctx, string(entry.Value))
+		if err != nil {
+			return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		var dao PlacementTaskDAO
+		if len(taskResp.Kvs) > 0 {
+			dao, err = placementTaskDAO(taskResp.Kvs[0])
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+		}
+		if deadlineKey, ok := dao.DeadlineKey(); !ok || deadlineKey != string(entry.Key) {
+			s.dropDeadline(ctx, entry)
+			continue
+		}
+		task := dao.task()
+		if !task.Expired(now) {
+			continue
+		}
+		expired = append(expired, domain.NodePlacement{
+			Config: domain.ConfigRef{
+				Org:       domain.Org(dao.Org),
+				Namespace: dao.Namespace,
+				Name:      dao.Name,
+				Version:   dao.Version,
+				Type:      dao.Type,
+			},
+			Task: *task,
+		})
+	}
+	return expired, nil
+}
+
+// dropDeadline deletes a stale index entry unless it was rewritten meanwhile.
+func (s PlacementEtcdStore) dropDeadline(ctx context.Context, entry *mvccpb.KeyValue) {
+	_, err := s.client.KV.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(string(entry.Key)), "=", entry.ModRevision)).
+		Then(clientv3.OpDelete(string(entry.Key))).
+		Commit()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s PlacementEtcdStore) listNodePlacements(ctx context.Context, prefix string) ([]domain.NodePlacement, *domain.Error) {
+	resp, err := s.client.KV.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+
+	placements := make([]domain.NodePlacement, 0, resp.Count)
+	for _, kv := range resp.Kvs {
+		dao, err := placementTaskDAO(kv)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		placements = append(placements, domain.NodePlacement{
+			Config: domain.ConfigRef{
+				Org:       domain.Org(dao.Org),
+				Namespace: dao.Namespace,
+				Name:      dao.Name,
+				Version:   dao.Version,
+				Type:      dao.Type,
+			},
+			Task: *dao.task(),
+		})
+	}
+
+	return placements, nil
+}
+
+func (s PlacementEtcdStore) UpdateStatus(ctx context.Context, org domain.Org, namespace, name string, version string, configType string, taskId string, report domain.TaskReport) *domain.Error {
 	key := PlacementTaskDAO{
 		Id:        taskId,
 		Org:       string(org),
@@ -80,6 +257,106 @@ func (s PlacementEtcdStore) UpdateStatus(ctx context.Context, org domain.Org, na
 		Name:      name,
 		Version:   version,
 	}.Key(configType)
+	return s.updateTask(ctx, key, configType, taskId, func(dao *PlacementTaskDAO) *domain.Error {
+		return applyReport(dao, report, time.Now().Unix())
+	})
+}
+
+// applyReport moves the task to the reported status, unless the report would
+// undo a resolution it can't follow from.
+func applyReport(dao *PlacementTaskDAO, report domain.TaskReport, now int64) *domain.Error {
+	if report.Status.Pending() && !dao.Status.Pending() {
+		return domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("task (id=%s) already resolved as %s", dao.Id, dao.Status))
+	}
+	if dao.Status == domain.PlacementTaskStatusSuperseded || dao.Status == domain.PlacementTaskStatusRemoved {
+		// the config isn't on the node anymore, a late report can't change that
+		return domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("task (id=%s) is already %s", dao.Id, dao.Status))
+	}
+	if report.Status == domain.PlacementTaskStatusRemoved && dao.Status != domain.PlacementTaskStatusPlaced {
+		return domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("task (id=%s) is %s, only placed configs can be removed", dao.Id, dao.Status))
+	}
+	reason := report.Reason
+	if dao.Status == domain.PlacementTaskStatusCancelled {
+		// the config was delivered before the cancellation and the agent
+		// applied it, which has to be recorded as it is on the node now
+		if report.Status != domain.PlacementTaskStatusPlaced {
+			return domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("task (id=%s) was cancelled", dao.Id))
+		}
+		reason = "applied after the task was cancelled"
+	}
+	dao.Status = report.Status
+	dao.Reason = reason
+	dao.Progress = report.Progress
+	dao.Duration = report.Duration
+	if !report.Status.Pending() {
+		dao.ResolvedAt = now
+	}
+	return nil
+}
+
+func (s PlacementEtcdStore) Supersede(ctx context.Context, config domain.ConfigRef, taskId, supersededBy string) *domain.Error {
+	return s.updateTask(ctx, taskKey(config, taskId), config.Type, taskId, func(dao *PlacementTaskDAO) *domain.Error {
+		dao.Status = domain.PlacementTaskStatusSuperseded
+		dao.SupersededBy = supersededBy
+		return nil
+	})
+}
+
+func (s PlacementEtcdStore) TimeOut(ctx context.Context, config domain.ConfigRef, taskId string) *domain.Error {
+	return s.updateTask(ctx, taskKey(config, taskId), config.Type, taskId, func(dao *PlacementTaskDAO) *domain.Error {
+		if !dao.Status.Pending() {
+			return domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("task (id=%s) already resolved as %s", taskId, dao.Status))
+		}
+		dao.Status = domain.PlacementTaskStatusTimedOut
+		dao.ResolvedAt = time.Now().Unix()
+		return nil
+	})
+}
+
+func (s PlacementEtcdStore) RecordDelivery(ctx context.Context, config domain.ConfigRef, taskId string, attempts int, deliveryErr string) *domain.Error {
+	return s.updateTask(ctx, taskKey(config, taskId), config.Type, taskId, func(dao *PlacementTaskDAO) *domain.Error {
+		dao.Attempts = attempts
+		dao.Reason = deliveryErr
+		return nil
+	})
+}
+
+func (s PlacementEtcdStore) FailDelivery(ctx context.Context, config domain.ConfigRef, taskId, reason string) *domain.Error {
+	return s.updateTask(ctx, taskKey(config, taskId), config.Type, taskId, func(dao *PlacementTaskDAO) *domain.Error {
+		if !dao.Status.Pending() {
+			return domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("task (id=%s) already resolved as %s", taskId, dao.Status))
+		}
+		dao.Status = domain.PlacementTaskStatusFailed
+		dao.Reason = reason
+		dao.ResolvedAt = time.Now().Unix()
+		return nil
+	})
+}
+
+func (s PlacementEtcdStore) RequestRemoval(ctx context.Context, config domain.ConfigRef, taskId string) *domain.Error {
+	return s.updateTask(ctx, taskKey(config, taskId), config.Type, taskId, func(dao *PlacementTaskDAO) *domain.Error {
+		if dao.Status != domain.PlacementTaskStatusPlaced {
+			return domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("task (id=%s) is %s, only placed configs can be removed", taskId, dao.Status))
+		}
+		dao.RemovalRequestedAt = time.Now().Unix()
+		return nil
+	})
+}
+
+func (s PlacementEtcdStore) Cancel(ctx context.Context, config domain.ConfigRef, taskId string) *domain.Error {
+	return s.updateTask(ctx, taskKey(config, taskId), config.Type, taskId, func(dao *PlacementTaskDAO) *domain.Error {
+		if dao.Status != domain.PlacementTaskStatusAccepted {
+			return domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("task (id=%s) is already %s", taskId, dao.Status))
+		}
+		dao.Status = domain.PlacementTaskStatusCancelled
+		dao.ResolvedAt = time.Now().Unix()
+		return nil
+	})
+}
+
+// updateTask changes the task only if it wasn't modified since it was read,
+// so that a status reported by an agent isn't overwritten by the reaper.
+func (s PlacementEtcdStore) updateTask(ctx context.Context, key, configType, taskId string, update func(dao *PlacementTaskDAO) *domain.Error) *domain.Error {
 	resp, err := s.client.KV.Get(ctx, key)
 	if err != nil {
 		return domain.NewError(domain.ErrTypeDb, err.Error())
@@ -88,44 +365,131 @@ func (s PlacementEtcdStore) UpdateStatus(ctx context.Context, org domain.Org, na
 		return domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("task (id=%s) not found", taskId))
 	}
 
-	dao, err := NewPlacementTaskDAO(resp.Kvs[0].Value)
+	dao, err := placementTaskDAO(resp.Kvs[0])
 	if err != nil {
 		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
 	}
 
-	dao.Status = status
-	dao.ResolvedAt = time.Now().Unix()
+	dao.Type = configType
+	prevDeadlineKey, indexed := dao.DeadlineKey()
+	if err := update(&dao); err != nil {
+		return err
+	}
 
 	value, err := dao.Marshal()
 	if err != nil {
 		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
 	}
 
-	_, err = s.client.Put(ctx, key, value)
+	ops := []clientv3.Op{clientv3.OpPut(key, value), clientv3.OpPut(dao.NodeKey(configType), value)}
+	deadlineKey, pending := dao.DeadlineKey()
+	if indexed && (!pending || deadlineKey != prevDeadlineKey) {
+		ops = append(ops, clientv3.OpDelete(prevDeadlineKey))
+	}
+	if pending && (!indexed || deadlineKey != prevDeadlineKey) {
+		ops = append(ops, clientv3.OpPut(deadlineKey, key))
+	}
+	txnResp, err := s.client.KV.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", resp.Kvs[0].ModRevision)).
+		Then(ops...).
+		Commit()
 	if err != nil {
 		return domain.NewError(domain.ErrTypeDb, err.Error())
 	}
+	if !txnResp.Succeeded {
+		return domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("task (id=%s) was modified concurrently", taskId))
+	}
 	return nil
 }
 
+func taskKey(config domain.ConfigRef, taskId string) string {
+	return PlacementTaskDAO{
+		Id:        taskId,
+		Org:       string(config.Org),
+		Namespace: config.Namespace,
+		Name:      config.Name,
+		Version:   config.Version,
+	}.Key(config.Type)
+}
+
 type PlacementTaskDAO struct {
 	Id         string
 	Org        string
 	Namespace  string
 	Name       string
 	Version    string
+	Type       string
 	Node       string
 	Status     domain.PlacementTaskStatus
 	AcceptedAt int64
 	ResolvedAt int64
+	RollbackOf string
+	// version of the config placed by the RollbackOf task
+	RollbackOfVersion string
+	SupersededBy      string
+	Deadline          int64
+	Attempts          int
+	Reason            string
+	Seed              string
+	Progress          int32
+	Duration          time.Duration
+	PlacementId       string
+	// the create revision of the task's key, stored with the task once it's
+	// updated so that index entries written later keep the task's order
+	Sequence           int64
+	RemovalRequestedAt int64
+}
+
+func (dao PlacementTaskDAO) task() *domain.PlacementTask {
+	task := domain.NewPlacementTask(dao.Id, domain.Node(dao.Node), dao.Status, dao.AcceptedAt, dao.ResolvedAt)
+	task.SetRollbackOf(dao.RollbackOf, dao.RollbackOfVersion)
+	task.SetSupersededBy(dao.SupersededBy)
+	task.SetDeadline(dao.Deadline)
+	task.SetAttempts(dao.Attempts)
+	task.SetReason(dao.Reason)
+	task.SetSeed(dao.Seed)
+	task.SetProgress(dao.Progress)
+	task.SetDuration(dao.Duration)
+	task.SetPlacementId(dao.PlacementId)
+	task.SetSequence(dao.Sequence)
+	task.SetRemovalRequestedAt(dao.RemovalRequestedAt)
+	return task
 }
 
 func (dao PlacementTaskDAO) Key(configType string) string {
-	return fmt.Sprintf("placements/%s/%s/%s/%s/%s/%s", configType, dao.Org, dao.Namespace, dao.Name, dao.Version, dao.Id)
+	return key("placements", configType, dao.Org, dao.Namespace, dao.Name, dao.Version, dao.Id)
 }
 
 func (dao PlacementTaskDAO) KeyPrefixByConfig(configType string) string {
-	return fmt.Sprintf("placements/%s/%s/%s/%s/%s/", configType, dao.Org, dao.Namespace, dao.Name, dao.Version)
+	return keyPrefix("placements", configType, dao.Org, dao.Namespace, dao.Name, dao.Version)
+}
+
+func (dao PlacementTaskDAO) NodeKey(configType string) string {
+	return key("nodes", dao.Org, dao.Node, configType, dao.Namespace, dao.Name, dao.Version, dao.Id)
+}
+
+func (dao PlacementTaskDAO) KeyPrefixByNode() string {
+	return keyPrefix("nodes", dao.Org, dao.Node)
+}
+
+func (dao PlacementTaskDAO) KeyPrefixByOrg() string {
+	return keyPrefix("nodes", dao.Org)
+}
+
+// DeadlineKey indexes a pending task by the time it expires at, so that the
+// reaper doesn't have to read resolved tasks. It's false for tasks that can't
+// expire.
+func (dao PlacementTaskDAO) DeadlineKey() (string, bool) {
+	if !dao.Status.Pending() || dao.Deadline <= 0 || dao.Id == "" {
+		return "", false
+	}
+	return key("deadlines", fmt.Sprintf("%012d", dao.Deadline), dao.Id), true
+}
+
+// deadlinePrefix is the start of the index entries of tasks that expire at
+// deadline, the unix time padded so that keys sort by time.
+func deadlinePrefix(deadline int64) string {
+	return keyPrefix("deadlines", fmt.Sprintf("%012d", deadline))
 }
 
 func (dao PlacementTaskDAO) Marshal() (string, error) {
@@ -133,6 +497,19 @@ func (dao PlacementTaskDAO) Marshal() (string, error) {
 	return string(jsonBytes), err
 }
 
+// placementTaskDAO parses a stored task, ordering it by the revision it was
+// created at unless it records its sequence itself.
+func placementTaskDAO(kv *mvccpb.KeyValue) (PlacementTaskDAO, error) {
+	dao, err := NewPlacementTaskDAO(kv.Value)
+	if err != nil {
+		return PlacementTaskDAO{}, err
+	}
+	if dao.Sequence == 0 {
+		dao.Sequence = kv.CreateRevision
+	}
+	return dao, nil
+}
+
 func NewPlacementTaskDAO(marshalled []byte) (PlacementTaskDAO, error) {
 	dao := &PlacementTaskDAO{}
 	err := json.Unmarshal(marshalled, dao)
@@ -0,0 +1,219 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// testEtcdClient connects to the etcd at ETCD_ADDRESS, skipping the test if
+// there's none.
+func testEtcdClient(t *testing.T) *clientv3.Client {
+	t.Helper()
+	address := os.Getenv("ETCD_ADDRESS")
+	if address == "" {
+		t.Skip("ETCD_ADDRESS not set")
+	}
+	client, err := clientv3.New(clientv3.Config{Endpoints: []string{address}, DialTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestPlacementTaskDeadlineKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		dao     PlacementTaskDAO
+		indexed bool
+		want    string
+	}{
+		{
+			name:    "pending task with a deadline",
+			dao:     PlacementTaskDAO{Id: "t1", Status: domain.PlacementTaskStatusAccepted, Deadline: 1700000000},
+			indexed: true,
+			want:    "deadlines/001700000000/t1",
+		},
+		{
+			name:    "in progress tasks can still expire",
+			dao:     PlacementTaskDAO{Id: "t1", Status: domain.PlacementTaskStatusInProgress, Deadline: 5},
+			indexed: true,
+			want:    "deadlines/000000000005/t1",
+		},
+		{
+			name: "resolved task",
+			dao:  PlacementTaskDAO{Id: "t1", Status: domain.PlacementTaskStatusPlaced, Deadline: 5},
+		},
+		{
+			name: "cancelled task",
+			dao:  PlacementTaskDAO{Id: "t1", Status: domain.PlacementTaskStatusCancelled, Deadline: 5},
+		},
+		{
+			name: "no deadline",
+			dao:  PlacementTaskDAO{Id: "t1", Status: domain.PlacementTaskStatusAccepted},
+		},
+		{
+			name: "task not read",
+			dao:  PlacementTaskDAO{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, indexed := tt.dao.DeadlineKey()
+			if indexed != tt.indexed || key != tt.want {
+				t.Errorf("DeadlineKey() = %q, %t, want %q, %t", key, indexed, tt.want, tt.indexed)
+			}
+		})
+	}
+}
+
+func TestDeadlineRange(t *testing.T) {
+	const now = 1000
+	tests := []struct {
+		name     string
+		deadline int64
+		expired  bool
+	}{
+		{name: "long past", deadline: 1, expired: true},
+		{name: "just now", deadline: now, expired: true},
+		{name: "next second", deadline: now + 1, expired: false},
+		{name: "more digits", deadline: now * 10, expired: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, _ := PlacementTaskDAO{Id: "t1", Deadline: tt.deadline}.DeadlineKey()
+			// the range ListExpired reads
+			inRange := key >= keyPrefix("deadlines") && key < deadlinePrefix(now+1)
+			if inRange != tt.expired {
+				t.Errorf("%s in range = %t, want %t", key, inRange, tt.expired)
+			}
+		})
+	}
+}
+
+func TestApplyReport(t *testing.T) {
+	const (
+		accepted   = domain.PlacementTaskStatusAccepted
+		inProgress = domain.PlacementTaskStatusInProgress
+		placed     = domain.PlacementTaskStatusPlaced
+		failed     = domain.PlacementTaskStatusFailed
+		rejected   = domain.PlacementTaskStatusRejected
+		timedOut   = domain.PlacementTaskStatusTimedOut
+		cancelled  = domain.PlacementTaskStatusCancelled
+		superseded = domain.PlacementTaskStatusSuperseded
+		removed    = domain.PlacementTaskStatusRemoved
+	)
+	tests := []struct {
+		name     string
+		from     domain.PlacementTaskStatus
+		report   domain.PlacementTaskStatus
+		conflict bool
+		reason   string
+		resolved bool
+	}{
+		{name: "progress", from: accepted, report: inProgress},
+		{name: "more progress", from: inProgress, report: inProgress},
+		{name: "placed", from: inProgress, report: placed, resolved: true},
+		{name: "failed", from: accepted, report: failed, reason: "reported", resolved: true},
+		{name: "rejected", from: accepted, report: rejected, reason: "reported", resolved: true},
+		{name: "progress after the outcome", from: placed, report: inProgress, conflict: true},
+		{name: "late outcome after a timeout", from: timedOut, report: placed, resolved: true},
+		{name: "placed again", from: placed, report: placed, resolved: true},
+		{name: "removal acknowledged", from: placed, report: removed, resolved: true},
+		{name: "removal of a failed task", from: failed, report: removed, conflict: true},
+		{name: "removal of a pending task", from: accepted, report: removed, conflict: true},
+		{name: "applied after a cancellation", from: cancelled, report: placed, reason: "applied after the task was cancelled", resolved: true},
+		{name: "failed after a cancellation", from: cancelled, report: failed, conflict: true},
+		{name: "progress after a cancellation", from: cancelled, report: inProgress, conflict: true},
+		{name: "late report on a superseded task", from: superseded, report: placed, conflict: true},
+		{name: "late report on a removed task", from: removed, report: placed, conflict: true},
+		{name: "removed twice", from: removed, report: removed, conflict: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dao := PlacementTaskDAO{Id: "t1", Status: tt.from, Reason: "before"}
+			err := applyReport(&dao, domain.TaskReport{Status: tt.report, Reason: "reported", Progress: 50}, 1000)
+			if tt.conflict {
+				if err == nil || err.ErrType() != domain.ErrTypeConflict {
+					t.Fatalf("err = %v, want a conflict", err)
+				}
+				if dao.Status != tt.from || dao.Reason != "before" {
+					t.Errorf("task changed to %s (%s) despite the conflict", dao.Status, dao.Reason)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			reason := tt.reason
+			if reason == "" {
+				reason = "reported"
+			}
+			if dao.Status != tt.report || dao.Reason != reason || dao.Progress != 50 {
+				t.Errorf("task is %s (%s) at %d%%, want %s (%s) at 50%%", dao.Status, dao.Reason, dao.Progress, tt.report, reason)
+			}
+			if resolved := dao.ResolvedAt == 1000; resolved != tt.resolved {
+				t.Errorf("resolved = %t, want %t", resolved, tt.resolved)
+			}
+		})
+	}
+}
+
+func TestPlacementEtcdStoreExpiry(t *testing.T) {
+	client := testEtcdClient(t)
+	store := NewPlacementEtcdStore(client)
+	tests := []struct {
+		name    string
+		reports []domain.PlacementTaskStatus
+		expired bool
+	}{
+		{name: "never reported", expired: true},
+		{name: "still in progress", reports: []domain.PlacementTaskStatus{domain.PlacementTaskStatusInProgress}, expired: true},
+		{name: "in progress twice", reports: []domain.PlacementTaskStatus{domain.PlacementTaskStatusInProgress, domain.PlacementTaskStatusInProgress}, expired: true},
+		{name: "placed", reports: []domain.PlacementTaskStatus{domain.PlacementTaskStatusPlaced}, expired: false},
+		{name: "placed after progress", reports: []domain.PlacementTaskStatus{domain.PlacementTaskStatusInProgress, domain.PlacementTaskStatusPlaced}, expired: false},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			org := domain.Org(fmt.Sprintf("expiry-test-%d-%d", time.Now().UnixNano(), i))
+			config := domain.NewStandaloneConfig(org, "ns", "v1", *domain.NewParamSet("db", map[string]string{"k": "v"}))
+			now := time.Now()
+			task := domain.NewPlacementTask("t1", "n1", domain.PlacementTaskStatusAccepted, now.Unix(), 0)
+			task.SetDeadline(now.Unix() + 1)
+			t.Cleanup(func() {
+				client.Delete(ctx, keyPrefix("placements", config.Type(), string(org)), clientv3.WithPrefix())
+				client.Delete(ctx, keyPrefix("nodes", string(org)), clientv3.WithPrefix())
+				deadlineKey, _ := PlacementTaskDAO{Id: task.Id(), Status: domain.PlacementTaskStatusAccepted, Deadline: task.Deadline()}.DeadlineKey()
+				client.Delete(ctx, deadlineKey)
+			})
+
+			if err := store.Place(ctx, config, task); err != nil {
+				t.Fatal(err)
+			}
+			for _, status := range tt.reports {
+				if err := store.UpdateStatus(ctx, org, "ns", "db", "v1", config.Type(), task.Id(), domain.TaskReport{Status: status}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			expired, err := store.ListExpired(ctx, now.Add(2*time.Second))
+			if err != nil {
+				t.Fatal(err)
+			}
+			listed := false
+			for _, placement := range expired {
+				listed = listed || (placement.Config.Org == org && placement.Task.Id() == task.Id())
+			}
+			if listed != tt.expired {
+				t.Errorf("listed as expired = %t, want %t", listed, tt.expired)
+			}
+		})
+	}
+}
@@ -0,0 +1,426 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type RolloutEtcdStore struct {
+	client *clientv3.Client
+}
+
+func NewRolloutEtcdStore(client *clientv3.Client) domain.RolloutStore {
+	return RolloutEtcdStore{
+		client: client,
+	}
+}
+
+// etcd limits the number of operations in a transaction, 128 by default
+const maxTxnOps = 100
+
+// Create stores the stages before the rollout itself. Stages of a rollout that
+// failed to be created are never read, as rollout ids are never reused.
+func (s RolloutEtcdStore) Create(ctx context.Context, rollout *domain.Rollout) *domain.Error {
+	dao := newRolloutDAO(rollout)
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	stageOps, marshalErr := stagePuts(newRolloutStageDAOs(rollout), nil)
+	if marshalErr != nil {
+		return marshalErr
+	}
+	for len(stageOps) > 0 {
+		batch := stageOps[:min(len(stageOps), maxTxnOps)]
+		stageOps = stageOps[len(batch):]
+		if _, err := s.client.KV.Txn(ctx).Then(batch...).Commit(); err != nil {
+			return domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+	}
+	key := dao.Key()
+	resp, err := s.client.KV.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, value)).
+		Commit()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if !resp.Succeeded {
+		return domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("rollout (id=%s) already exists", rollout.Id))
+	}
+	rollout.Revision = resp.Header.Revision
+	return nil
+}
+
+func (s RolloutEtcdStore) Get(ctx context.Context, org domain.Org, id string) (*domain.Rollout, *domain.Error) {
+	dao := RolloutDAO{Org: string(org), Id: id}
+	resp, err := s.client.KV.Txn(ctx).
+		Then(clientv3.OpGet(dao.Key()), clientv3.OpGet(dao.KeyPrefixStages(), clientv3.WithPrefix())).
+		Commit()
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	kvs := resp.Responses[0].GetResponseRange().Kvs
+	if len(kvs) == 0 {
+		return nil, domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("rollout (id=%s) not found", id))
+	}
+	dao, err = NewRolloutDAO(kvs[0].Value)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	return dao.toDomain(kvs[0].ModRevision, parseRolloutStages(resp.Responses[1].GetResponseRange().Kvs)), nil
+}
+
+// Update only rewrites the stages that changed, so that an update usually
+// fits in a single transaction no matter how many stages a rollout has. Those
+// that don't fit, such as every stage of a legacy rollout being migrated, are
+// written ahead of the rollout.
+func (s RolloutEtcdStore) Update(ctx context.Context, rollout *domain.Rollout) *domain.Error {
+	dao := newRolloutDAO(rollout)
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	stored, err := s.client.KV.Get(ctx, dao.KeyPrefixStages(), clientv3.WithPrefix())
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	current := make(map[string][]byte, len(stored.Kvs))
+	for _, kv := range stored.Kvs {
+		current[string(kv.Key)] = kv.Value
+	}
+	ops, marshalErr := stagePuts(newRolloutStageDAOs(rollout), current)
+	if marshalErr != nil {
+		return marshalErr
+	}
+	key := dao.Key()
+	// stages are only written along with the rollout, so they can't have
+	// changed since they were read unless the rollout did as well
+	unchanged := clientv3.Compare(clientv3.ModRevision(key), "=", rollout.Revision)
+	var resp *clientv3.TxnResponse
+	for _, batch := range txnBatches(ops, clientv3.OpPut(key, value)) {
+		resp, err = s.client.KV.Txn(ctx).If(unchanged).Then(batch...).Commit()
+		if err != nil {
+			return domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if !resp.Succeeded {
+			return domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("rollout (id=%s) was modified concurrently", rollout.Id))
+		}
+	}
+	rollout.Revision = resp.Header.Revision
+	return nil
+}
+
+// txnBatches splits ops into transactions of at most maxTxnOps operations,
+// the last of which ends with last.
+func txnBatches(ops []clientv3.Op, last clientv3.Op) [][]clientv3.Op {
+	batches := make([][]clientv3.Op, 0, len(ops)/maxTxnOps+1)
+	for len(ops) >= maxTxnOps {
+		batches = append(batches, ops[:maxTxnOps])
+		ops = ops[maxTxnOps:]
+	}
+	return append(batches, append(slices.Clone(ops), last))
+}
+
+func (s RolloutEtcdStore) ListActive(ctx context.Context) ([]*domain.Rollout, *domain.Error) {
+	return s.list(ctx, keyPrefix("rollouts"), func(dao RolloutDAO) bool {
+		return !dao.Status.Terminal()
+	})
+}
+
+func (s RolloutEtcdStore) ListByOrg(ctx context.Context, org domain.Org) ([]*domain.Rollout, *domain.Error) {
+	return s.list(ctx, RolloutDAO{Org: string(org)}.KeyPrefixByOrg(), func(dao RolloutDAO) bool {
+		return true
+	})
+}
+
+func (s RolloutEtcdStore) list(ctx context.Context, prefix string, include func(dao RolloutDAO) bool) ([]*domain.Rollout, *domain.Error) {
+	resp, err := s.client.KV.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	rollouts := make([]*domain.Rollout, 0)
+	for _, kv := range resp.Kvs {
+		dao, err := NewRolloutDAO(kv.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if !include(dao) {
+			continue
+		}
+		// read the stages as of the rollouts, so they match
+		stages, err := s.client.KV.Get(ctx, dao.KeyPrefixStages(), clientv3.WithPrefix(), clientv3.WithRev(resp.Header.Revision))
+		if err != nil {
+			return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		rollouts = append(rollouts, dao.toDomain(kv.ModRevision, parseRolloutStages(stages.Kvs)))
+	}
+	return rollouts, nil
+}
+
+// stagePuts returns the puts of the stages whose stored value differs from
+// current, which holds the stored values by key.
+func stagePuts(stages []RolloutStageDAO, current map[string][]byte) ([]clientv3.Op, *domain.Error) {
+	ops := make([]clientv3.Op, 0)
+	for _, stage := range stages {
+		value, err := stage.Marshal()
+		if err != nil {
+			return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+		}
+		if stored, ok := current[stage.Key()]; ok && string(stored) == value {
+			continue
+		}
+		ops = append(ops, clientv3.OpPut(stage.Key(), value))
+	}
+	return ops, nil
+}
+
+func parseRolloutStages(kvs []*mvccpb.KeyValue) []RolloutStageDAO {
+	stages := make([]RolloutStageDAO, 0, len(kvs))
+	for _, kv := range kvs {
+		stage, err := NewRolloutStageDAO(kv.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		stages = append(stages, stage)
+	}
+	slices.SortFunc(stages, func(a, b RolloutStageDAO) int {
+		return a.Stage - b.Stage
+	})
+	return stages
+}
+
+type RolloutTaskDAO struct {
+	Node   string
+	TaskId string
+	Stage  int
+	Status domain.PlacementTaskStatus
+}
+
+// RolloutDAO holds the state of a rollout, while its nodes and tasks are
+// stored per stage, see RolloutStageDAO.
+type RolloutDAO struct {
+	Id              string
+	Kind            domain.RolloutKind
+	Org             string
+	Namespace       string
+	Name            string
+	Version         string
+	Type            string
+	Strategy        string
+	Seed            string
+	MaxFailureRatio float64
+	MaxFailures     int
+	Timeout         time.Duration
+	CurrentStage    int
+	Dispatched      int
+	Status          domain.RolloutStatus
+	Reason          string
+	CreatedAt       int64
+	UpdatedAt       int64
+	// rollouts stored before stages got their own keys
+	Nodes  []string         `json:",omitempty"`
+	Stages []int            `json:",omitempty"`
+	Tasks  []RolloutTaskDAO `json:",omitempty"`
+}
+
+func newRolloutDAO(rollout *domain.Rollout) RolloutDAO {
+	return RolloutDAO{
+		Id:              rollout.Id,
+		Kind:            rollout.Kind,
+		Org:             string(rollout.Config.Org),
+		Namespace:       rollout.Config.Namespace,
+		Name:            rollout.Config.Name,
+		Version:         rollout.Config.Version,
+		Type:            rollout.Config.Type,
+		Strategy:        rollout.Strategy,
+		Seed:            rollout.Seed,
+		MaxFailureRatio: rollout.MaxFailureRatio,
+		MaxFailures:     rollout.MaxFailures,
+		Timeout:         rollout.Timeout,
+		CurrentStage:    rollout.CurrentStage,
+		Dispatched:      rollout.Dispatched,
+		Status:          rollout.Status,
+		Reason:          rollout.Reason,
+		CreatedAt:       rollout.CreatedAt,
+		UpdatedAt:       rollout.UpdatedAt,
+	}
+}
+
+func (dao RolloutDAO) toDomain(revision int64, stages []RolloutStageDAO) *domain.Rollout {
+	// the rollout only drops its legacy stages once all of the migrated
+	// ones are written, those read before then may be incomplete
+	if len(dao.Stages) > 0 {
+		stages = dao.legacyStages()
+	}
+	nodes := make([]domain.Node, 0)
+	tasks := make([]domain.RolloutTask, 0)
+	cumulative := make([]int, 0, len(stages))
+	for _, stage := range stages {
+		for _, node := range stage.Nodes {
+			nodes = append(nodes, domain.Node(node))
+		}
+		cumulative = append(cumulative, len(nodes))
+		for _, task := range stage.Tasks {
+			tasks = append(tasks, domain.RolloutTask{
+				Node:   domain.Node(task.Node),
+				TaskId: task.TaskId,
+				Stage:  stage.Stage,
+				Status: task.Status,
+			})
+		}
+	}
+	kind := dao.Kind
+	if kind == "" {
+		// rollouts created before rolling rollouts existed
+		kind = domain.RolloutKindCanary
+	}
+	return &domain.Rollout{
+		Id:   dao.Id,
+		Kind: kind,
+		Config: domain.ConfigRef{
+			Org:       domain.Org(dao.Org),
+			Namespace: dao.Namespace,
+			Name:      dao.Name,
+			Version:   dao.Version,
+			Type:      dao.Type,
+		},
+		Strategy:        dao.Strategy,
+		Seed:            dao.Seed,
+		Nodes:           nodes,
+		Stages:          cumulative,
+		MaxFailureRatio: dao.MaxFailureRatio,
+		MaxFailures:     dao.MaxFailures,
+		Timeout:         dao.Timeout,
+		CurrentStage:    dao.CurrentStage,
+		Dispatched:      dao.Dispatched,
+		Tasks:           tasks,
+		Status:          dao.Status,
+		Reason:          dao.Reason,
+		CreatedAt:       dao.CreatedAt,
+		UpdatedAt:       dao.UpdatedAt,
+		Revision:        revision,
+	}
+}
+
+// legacyStages splits the nodes and tasks of a rollout stored in a single
+// value into its stages, which are written on its next update.
+func (dao RolloutDAO) legacyStages() []RolloutStageDAO {
+	stages := make([]RolloutStageDAO, 0, len(dao.Stages))
+	prev := 0
+	for i, end := range dao.Stages {
+		end = min(end, len(dao.Nodes))
+		stage := RolloutStageDAO{
+			Org:       dao.Org,
+			RolloutId: dao.Id,
+			Stage:     i,
+			Nodes:     dao.Nodes[prev:end],
+			Tasks:     make([]RolloutTaskDAO, 0),
+		}
+		for _, task := range dao.Tasks {
+			if task.Stage == i {
+				stage.Tasks = append(stage.Tasks, task)
+			}
+		}
+		stages = append(stages, stage)
+		prev = max(prev, end)
+	}
+	return stages
+}
+
+func (dao RolloutDAO) Key() string {
+	return key("rollouts", dao.Org, dao.Id)
+}
+
+func (dao RolloutDAO) KeyPrefixByOrg() string {
+	return keyPrefix("rollouts", dao.Org)
+}
+
+func (dao RolloutDAO) KeyPrefixStages() string {
+	return keyPrefix("rollout-stages", dao.Org, dao.Id)
+}
+
+func (dao RolloutDAO) Marshal() (string, error) {
+	jsonBytes, err := json.Marshal(dao)
+	return string(jsonBytes), err
+}
+
+func NewRolloutDAO(marshalled []byte) (RolloutDAO, error) {
+	dao := &RolloutDAO{}
+	err := json.Unmarshal(marshalled, dao)
+	if err != nil {
+		return RolloutDAO{}, err
+	}
+	return *dao, nil
+}
+
+// RolloutStageDAO holds the nodes of a single rollout stage and the tasks
+// sent to them.
+type RolloutStageDAO struct {
+	Org       string
+	RolloutId string
+	Stage     int
+	Nodes     []string
+	Tasks     []RolloutTaskDAO
+}
+
+func newRolloutStageDAOs(rollout *domain.Rollout) []RolloutStageDAO {
+	stages := make([]RolloutStageDAO, 0, len(rollout.Stages))
+	prev := 0
+	for i, end := range rollout.Stages {
+		end = min(end, len(rollout.Nodes))
+		stage := RolloutStageDAO{
+			Org:       string(rollout.Config.Org),
+			RolloutId: rollout.Id,
+			Stage:     i,
+			Nodes:     make([]string, 0, max(end-prev, 0)),
+			Tasks:     make([]RolloutTaskDAO, 0),
+		}
+		for _, node := range rollout.Nodes[min(prev, end):end] {
+			stage.Nodes = append(stage.Nodes, string(node))
+		}
+		stages = append(stages, stage)
+		prev = max(prev, end)
+	}
+	for _, task := range rollout.Tasks {
+		if task.Stage < 0 || task.Stage >= len(stages) {
+			log.Printf("rollout %s has task %s in unknown stage %d", rollout.Id, task.TaskId, task.Stage)
+			continue
+		}
+		stages[task.Stage].Tasks = append(stages[task.Stage].Tasks, RolloutTaskDAO{
+			Node:   string(task.Node),
+			TaskId: task.TaskId,
+			Stage:  task.Stage,
+			Status: task.Status,
+		})
+	}
+	return stages
+}
+
+func (dao RolloutStageDAO) Key() string {
+	return key("rollout-stages", dao.Org, dao.RolloutId, fmt.Sprintf("%06d", dao.Stage))
+}
+
+func (dao RolloutStageDAO) Marshal() (string, error) {
+	jsonBytes, err := json.Marshal(dao)
+	return string(jsonBytes), err
+}
+
+func NewRolloutStageDAO(marshalled []byte) (RolloutStageDAO, error) {
+	dao := &RolloutStageDAO{}
+	err := json.Unmarshal(marshalled, dao)
+	if err != nil {
+		return RolloutStageDAO{}, err
+	}
+	return *dao, nil
+}
@@ -0,0 +1,159 @@
+package store
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func TestRolloutStageRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		rollout *domain.Rollout
+	}{
+		{
+			name: "tasks in several stages",
+			rollout: &domain.Rollout{
+				Nodes:  []domain.Node{"n1", "n2", "n3", "n4"},
+				Stages: []int{1, 2, 4},
+				Tasks: []domain.RolloutTask{
+					{Node: "n1", TaskId: "t1", Stage: 0, Status: domain.PlacementTaskStatusPlaced},
+					{Node: "n2", TaskId: "t2", Stage: 1, Status: domain.PlacementTaskStatusFailed},
+					{Node: "n3", TaskId: "t3", Stage: 2, Status: domain.PlacementTaskStatusAccepted},
+				},
+			},
+		},
+		{
+			name: "no tasks yet",
+			rollout: &domain.Rollout{
+				Nodes:  []domain.Node{"n1", "n2"},
+				Stages: []int{1, 2},
+				Tasks:  []domain.RolloutTask{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.rollout.Id = "r1"
+			tt.rollout.Kind = domain.RolloutKindCanary
+			tt.rollout.Config = domain.ConfigRef{Org: "org", Namespace: "ns", Name: "db", Version: "v1", Type: domain.ConfTypeStandalone}
+			tt.rollout.Status = domain.RolloutStatusRunning
+			tt.rollout.Revision = 7
+
+			kvs := make([]*mvccpb.KeyValue, 0)
+			stages := newRolloutStageDAOs(tt.rollout)
+			// stored out of order, parsing sorts them
+			for i := len(stages) - 1; i >= 0; i-- {
+				value, err := stages[i].Marshal()
+				if err != nil {
+					t.Fatal(err)
+				}
+				kvs = append(kvs, &mvccpb.KeyValue{Key: []byte(stages[i].Key()), Value: []byte(value)})
+			}
+			value, err := newRolloutDAO(tt.rollout).Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			dao, err := NewRolloutDAO([]byte(value))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := dao.toDomain(7, parseRolloutStages(kvs))
+			if !reflect.DeepEqual(got, tt.rollout) {
+				t.Errorf("round trip = %+v, want %+v", got, tt.rollout)
+			}
+
+			current := make(map[string][]byte)
+			for _, kv := range kvs {
+				current[string(kv.Key)] = kv.Value
+			}
+			tt.rollout.Tasks = append(tt.rollout.Tasks, domain.RolloutTask{Node: "n2", TaskId: "t4", Stage: 1})
+			puts, putsErr := stagePuts(newRolloutStageDAOs(tt.rollout), current)
+			if putsErr != nil {
+				t.Fatal(putsErr)
+			}
+			if len(puts) != 1 || string(puts[0].KeyBytes()) != stages[1].Key() {
+				t.Errorf("%d stage puts, want only stage 1 to be written", len(puts))
+			}
+		})
+	}
+}
+
+func TestRolloutLegacyStages(t *testing.T) {
+	dao := RolloutDAO{
+		Id:     "r1",
+		Org:    "org",
+		Nodes:  []string{"n1", "n2", "n3"},
+		Stages: []int{1, 3},
+		Tasks: []RolloutTaskDAO{
+			{Node: "n1", TaskId: "t1", Stage: 0, Status: domain.PlacementTaskStatusPlaced},
+			{Node: "n2", TaskId: "t2", Stage: 1, Status: domain.PlacementTaskStatusAccepted},
+		},
+	}
+	tests := []struct {
+		name   string
+		stages []RolloutStageDAO
+	}{
+		{name: "not migrated"},
+		{
+			// an update that failed after writing the first batch of stages
+			name:   "partly migrated",
+			stages: []RolloutStageDAO{{Org: "org", RolloutId: "r1", Stage: 0, Nodes: []string{"n1"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rollout := dao.toDomain(1, tt.stages)
+			if rollout.Kind != domain.RolloutKindCanary {
+				t.Errorf("kind = %s, want canary", rollout.Kind)
+			}
+			if !reflect.DeepEqual(rollout.Stages, []int{1, 3}) || len(rollout.Nodes) != 3 {
+				t.Errorf("stages = %v over %d nodes, want [1 3] over 3", rollout.Stages, len(rollout.Nodes))
+			}
+			if len(rollout.Tasks) != 2 || rollout.Tasks[1].Stage != 1 {
+				t.Errorf("tasks = %+v, want both tasks in their stages", rollout.Tasks)
+			}
+		})
+	}
+}
+
+func TestTxnBatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		ops     int
+		batches int
+	}{
+		{name: "only the rollout", ops: 0, batches: 1},
+		{name: "fits with the rollout", ops: maxTxnOps - 1, batches: 1},
+		{name: "one too many", ops: maxTxnOps, batches: 2},
+		{name: "legacy rollout with many stages", ops: 2*maxTxnOps + 30, batches: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := make([]clientv3.Op, 0, tt.ops)
+			for i := 0; i < tt.ops; i++ {
+				ops = append(ops, clientv3.OpPut(fmt.Sprintf("stage/%d", i), ""))
+			}
+			batches := txnBatches(ops, clientv3.OpPut("rollout", ""))
+			if len(batches) != tt.batches {
+				t.Fatalf("%d batches, want %d", len(batches), tt.batches)
+			}
+			written := make([]string, 0, tt.ops+1)
+			for _, batch := range batches {
+				if len(batch) > maxTxnOps {
+					t.Errorf("batch of %d ops, want at most %d", len(batch), maxTxnOps)
+				}
+				for _, op := range batch {
+					written = append(written, string(op.KeyBytes()))
+				}
+			}
+			if len(written) != tt.ops+1 || written[len(written)-1] != "rollout" {
+				t.Errorf("wrote %d keys ending with %q, want %d ending with the rollout", len(written), written[len(written)-1], tt.ops+1)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type ScheduledPlacementEtcdStore struct {
+	client *clientv3.Client
+}
+
+func NewScheduledPlacementEtcdStore(client *clientv3.Client) domain.ScheduledPlacementStore {
+	return ScheduledPlacementEtcdStore{
+		client: client,
+	}
+}
+
+func (s ScheduledPlacementEtcdStore) Create(ctx context.Context, placement *domain.ScheduledPlacement) *domain.Error {
+	dao := newScheduledPlacementDAO(placement)
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	key := dao.Key()
+	resp, err := s.client.KV.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, value)).
+		Commit()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if !resp.Succeeded {
+		return domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("scheduled placement (id=%s) already exists", placement.Id))
+	}
+	placement.Revision = resp.Header.Revision
+	return nil
+}
+
+func (s ScheduledPlacementEtcdStore) Get(ctx context.Context, org domain.Org, id string) (*domain.ScheduledPlacement, *domain.Error) {
+	key := ScheduledPlacementDAO{Org: string(org), Id: id}.Key()
+	resp, err := s.client.KV.Get(ctx, key)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("scheduled placement (id=%s) not found", id))
+	}
+	dao, err := NewScheduledPlacementDAO(resp.Kvs[0].Value)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	return dao.toDomain(resp.Kvs[0].ModRevision), nil
+}
+
+func (s ScheduledPlacementEtcdStore) Update(ctx context.Context, placement *domain.ScheduledPlacement) *domain.Error {
+	dao := newScheduledPlacementDAO(placement)
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	key := dao.Key()
+	resp, err := s.client.KV.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", placement.Revision)).
+		Then(clientv3.OpPut(key, value)).
+		Commit()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if !resp.Succeeded {
+		return domain.NewError(domain.ErrTypeConflict, fmt.Sprintf("scheduled placement (id=%s) was modified concurrently", placement.Id))
+	}
+	placement.Revision = resp.Header.Revision
+	return nil
+}
+
+func (s ScheduledPlacementEtcdStore) ListDue(ctx context.Context, now time.Time) ([]*domain.ScheduledPlacement, *domain.Error) {
+	return s.list(ctx, keyPrefix("schedules"), func(placement *domain.ScheduledPlacement) bool {
+		return placement.Due(now)
+	})
+}
+
+func (s ScheduledPlacementEtcdStore) ListStuck(ctx context.Context, startedBefore time.Time) ([]*domain.ScheduledPlacement, *domain.Error) {
+	return s.list(ctx, keyPrefix("schedules"), func(placement *domain.ScheduledPlacement) bool {
+		return placement.Stuck(startedBefore)
+	})
+}
+
+func (s ScheduledPlacementEtcdStore) ListByOrg(ctx context.Context, org domain.Org) ([]*domain.ScheduledPlacement, *domain.Error) {
+	return s.list(ctx, ScheduledPlacementDAO{Org: string(org)}.KeyPrefixByOrg(), func(placement *domain.ScheduledPlacement) bool {
+		return true
+	})
+}
+
+func (s ScheduledPlacementEtcdStore) list(ctx context.Context, prefix string, include func(placement *domain.ScheduledPlacement) bool) ([]*domain.ScheduledPlacement, *domain.Error) {
+	resp, err := s.client.KV.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	placements := make([]*domain.ScheduledPlacement, 0)
+	for _, kv := range resp.Kvs {
+		dao, err := NewScheduledPlacementDAO(kv.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		placement := dao.toDomain(kv.ModRevision)
+		if include(placement) {
+			placements = append(placements, placement)
+		}
+	}
+	return placements, nil
+}
+
+type ScheduledPlacementDAO struct {
+	Id         string
+	Org        string
+	Namespace  string
+	Name       string
+	Version    string
+	Type       string
+	Request    []byte
+	StartAt    int64
+	Status     domain.ScheduledPlacementStatus
+	Reason     string
+	TaskIds    []string
+	RolloutId  string
+	CreatedAt  int64
+	StartedAt  int64
+	ExecutedAt int64
+	// permissions of the identity the placement is executed as
+	Subject     string
+	Permissions []string
+	ExpiresAt   int64
+}
+
+func newScheduledPlacementDAO(placement *domain.ScheduledPlacement) ScheduledPlacementDAO {
+	return ScheduledPlacementDAO{
+		Id:          placement.Id,
+		Org:         string(placement.Config.Org),
+		Namespace:   placement.Config.Namespace,
+		Name:        placement.Config.Name,
+		Version:     placement.Config.Version,
+		Type:        placement.Config.Type,
+		Request:     placement.Request,
+		StartAt:     placement.StartAt,
+		Status:      placement.Status,
+		Reason:      placement.Reason,
+		TaskIds:     placement.TaskIds,
+		RolloutId:   placement.RolloutId,
+		CreatedAt:   placement.CreatedAt,
+		StartedAt:   placement.StartedAt,
+		ExecutedAt:  placement.ExecutedAt,
+		Subject:     placement.Identity.Subject,
+		Permissions: placement.Identity.Permissions,
+		ExpiresAt:   placement.Identity.ExpiresAt,
+	}
+}
+
+func (dao ScheduledPlacementDAO) toDomain(revision int64) *domain.ScheduledPlacement {
+	return &domain.ScheduledPlacement{
+		Id: dao.Id,
+		Config: domain.ConfigRef{
+			Org:       domain.Org(dao.Org),
+			Namespace: dao.Namespace,
+			Name:      dao.Name,
+			Version:   dao.Version,
+			Type:      dao.Type,
+		},
+		Request:    dao.Request,
+		StartAt:    dao.StartAt,
+		Status:     dao.Status,
+		Reason:     dao.Reason,
+		TaskIds:    dao.TaskIds,
+		RolloutId:  dao.RolloutId,
+		CreatedAt:  dao.CreatedAt,
+		StartedAt:  dao.StartedAt,
+		ExecutedAt: dao.ExecutedAt,
+		Identity: domain.ServiceIdentity{
+			Org:         domain.Org(dao.Org),
+			Subject:     dao.Subject,
+			Permissions: dao.Permissions,
+			ExpiresAt:   dao.ExpiresAt,
+		},
+		Revision: revision,
+	}
+}
+
+func (dao ScheduledPlacementDAO) Key() string {
+	return key("schedules", dao.Org, dao.Id)
+}
+
+func (dao ScheduledPlacementDAO) KeyPrefixByOrg() string {
+	return keyPrefix("schedules", dao.Org)
+}
+
+func (dao ScheduledPlacementDAO) Marshal() (string, error) {
+	jsonBytes, err := json.Marshal(dao)
+	return string(jsonBytes), err
+}
+
+func NewScheduledPlacementDAO(marshalled []byte) (ScheduledPlacementDAO, error) {
+	dao := &ScheduledPlacementDAO{}
+	err := json.Unmarshal(marshalled, dao)
+	if err != nil {
+		return ScheduledPlacementDAO{}, err
+	}
+	return *dao, nil
+}
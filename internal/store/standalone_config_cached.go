@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"log"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type StandaloneConfigCachedStore struct {
+	store domain.StandaloneConfigStore
+	cache *lruCache
+}
+
+func NewStandaloneConfigCachedStore(ctx context.Context, client *clientv3.Client, store domain.StandaloneConfigStore, maxEntries int) domain.StandaloneConfigStore {
+	cache := newLRUCache("standalone", maxEntries)
+	go cache.watch(ctx, client, "standalone/")
+	return StandaloneConfigCachedStore{
+		store: store,
+		cache: cache,
+	}
+}
+
+func (s StandaloneConfigCachedStore) Put(ctx context.Context, config *domain.StandaloneConfig) *domain.Error {
+	return s.store.Put(ctx, config)
+}
+
+func (s StandaloneConfigCachedStore) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.StandaloneConfig, *domain.Error) {
+	if domain.IsLinearizableRead(ctx) {
+		return s.store.Get(ctx, org, namespace, name, version)
+	}
+	key := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}.Key()
+	if value, ok := s.cache.get(key); ok {
+		dao, err := NewStandaloneConfigDAO(value)
+		if err == nil {
+			return dao.config(), nil
+		}
+		log.Println(err)
+	}
+	load := s.cache.startLoad(key)
+	config, err := s.store.Get(ctx, org, namespace, name, version)
+	if err != nil {
+		s.cache.abandon(key, load)
+		return nil, err
+	}
+	value, marshalErr := newStandaloneConfigDAO(config).Marshal()
+	if marshalErr != nil {
+		s.cache.abandon(key, load)
+		return config, nil
+	}
+	s.cache.add(key, []byte(value), load)
+	return config, nil
+}
+
+func (s StandaloneConfigCachedStore) List(ctx context.Context, org domain.Org, namespace string) ([]*domain.StandaloneConfig, *domain.Error) {
+	return s.store.List(ctx, org, namespace)
+}
+
+func (s StandaloneConfigCachedStore) Search(ctx context.Context, org domain.Org, pageToken string, limit int, match func(config *domain.StandaloneConfig) bool) ([]*domain.StandaloneConfig, string, *domain.Error) {
+	return s.store.Search(ctx, org, pageToken, limit, match)
+}
+
+func (s StandaloneConfigCachedStore) Delete(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.StandaloneConfig, *domain.Error) {
+	s.cache.invalidate(StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}.Key())
+	return s.store.Delete(ctx, org, namespace, name, version)
+}
@@ -21,15 +21,7 @@ func NewStandaloneConfigEtcdStore(client *clientv3.Client) domain.StandaloneConf
 }
 
 func (s StandaloneConfigEtcdStore) Put(ctx context.Context, config *domain.StandaloneConfig) *domain.Error {
-	dao := StandaloneConfigDAO{
-		Org:       string(config.Org()),
-		Namespace: config.Namespace(),
-		Name:      config.Name(),
-		Version:   config.Version(),
-		CreatedAt: config.CreatedAtUnixSec(),
-		ParamSet:  config.ParamSet(),
-	}
-
+	dao := newStandaloneConfigDAO(config)
 	key := dao.Key()
 	value, err := dao.Marshal()
 	if err != nil {
@@ -66,9 +58,7 @@ func (s StandaloneConfigEtcdStore) Get(ctx context.Context, org domain.Org, name
 	if err != nil {
 		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
 	}
-
-	paramSet := domain.NewParamSet(dao.Name, dao.ParamSet)
-	return domain.InitStandaloneConfig(domain.Org(dao.Org), dao.Namespace, dao.Version, dao.CreatedAt, *paramSet), nil
+	return dao.config(), nil
 }
 
 func (s StandaloneConfigEtcdStore) List(ctx context.Context, org domain.Org, namespace string) ([]*domain.StandaloneConfig, *domain.Error) {
@@ -76,6 +66,23 @@ func (s StandaloneConfigEtcdStore) List(ctx context.Context, org domain.Org, nam
 		Org:       string(org),
 		Namespace: namespace,
 	}.KeyPrefixAll()
+	return s.listByPrefix(ctx, key)
+}
+
+func (s StandaloneConfigEtcdStore) Search(ctx context.Context, org domain.Org, pageToken string, limit int, match func(config *domain.StandaloneConfig) bool) ([]*domain.StandaloneConfig, string, *domain.Error) {
+	prefix := StandaloneConfigDAO{
+		Org: string(org),
+	}.KeyPrefixOrg()
+	return scanPrefix(ctx, s.client, prefix, pageToken, limit, func(value []byte) (*domain.StandaloneConfig, error) {
+		dao, err := NewStandaloneConfigDAO(value)
+		if err != nil {
+			return nil, err
+		}
+		return dao.config(), nil
+	}, match)
+}
+
+func (s StandaloneConfigEtcdStore) listByPrefix(ctx context.Context, key string) ([]*domain.StandaloneConfig, *domain.Error) {
 	resp, err := s.client.KV.Get(ctx, key, clientv3.WithPrefix())
 	if err != nil {
 		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
@@ -88,8 +95,7 @@ func (s StandaloneConfigEtcdStore) List(ctx context.Context, org domain.Org, nam
 			log.Println(err)
 			continue
 		}
-		paramSet := domain.NewParamSet(dao.Name, dao.ParamSet)
-		configs = append(configs, domain.InitStandaloneConfig(domain.Org(dao.Org), dao.Namespace, dao.Version, dao.CreatedAt, *paramSet))
+		configs = append(configs, dao.config())
 	}
 
 	return configs, nil
@@ -115,9 +121,7 @@ func (s StandaloneConfigEtcdStore) Delete(ctx context.Context, org domain.Org, n
 	if err != nil {
 		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
 	}
-
-	paramSet := domain.NewParamSet(dao.Name, dao.ParamSet)
-	return domain.InitStandaloneConfig(domain.Org(dao.Org), dao.Namespace, dao.Version, dao.CreatedAt, *paramSet), nil
+	return dao.config(), nil
 }
 
 type StandaloneConfigDAO struct {
@@ -127,14 +131,38 @@ type StandaloneConfigDAO struct {
 	Version   string
 	CreatedAt int64
 	ParamSet  map[string]string
+	Labels    map[string]string
+}
+
+func newStandaloneConfigDAO(config *domain.StandaloneConfig) StandaloneConfigDAO {
+	return StandaloneConfigDAO{
+		Org:       string(config.Org()),
+		Namespace: config.Namespace(),
+		Name:      config.Name(),
+		Version:   config.Version(),
+		CreatedAt: config.CreatedAtUnixSec(),
+		ParamSet:  config.ParamSet(),
+		Labels:    config.Labels(),
+	}
+}
+
+func (dao StandaloneConfigDAO) config() *domain.StandaloneConfig {
+	paramSet := domain.NewParamSet(dao.Name, dao.ParamSet)
+	config := domain.InitStandaloneConfig(domain.Org(dao.Org), dao.Namespace, dao.Version, dao.CreatedAt, *paramSet)
+	config.SetLabels(dao.Labels)
+	return config
 }
 
 func (dao StandaloneConfigDAO) Key() string {
-	return fmt.Sprintf("standalone/%s/%s/%s/%s", dao.Org, dao.Namespace, dao.Name, dao.Version)
+	return key("standalone", dao.Org, dao.Namespace, dao.Name, dao.Version)
 }
 
 func (dao StandaloneConfigDAO) KeyPrefixAll() string {
-	return fmt.Sprintf("standalone/%s/%s/", dao.Org, dao.Namespace)
+	return keyPrefix("standalone", dao.Org, dao.Namespace)
+}
+
+func (dao StandaloneConfigDAO) KeyPrefixOrg() string {
+	return keyPrefix("standalone", dao.Org)
 }
 
 func (dao StandaloneConfigDAO) Marshal() (string, error) {
@@ -21,6 +21,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DiffFormat int32
+
+const (
+	DiffFormat_Structured  DiffFormat = 0
+	DiffFormat_JsonPatch   DiffFormat = 1
+	DiffFormat_MergePatch  DiffFormat = 2
+	DiffFormat_UnifiedText DiffFormat = 3
+)
+
+// Enum value maps for DiffFormat.
+var (
+	DiffFormat_name = map[int32]string{
+		0: "Structured",
+		1: "JsonPatch",
+		2: "MergePatch",
+		3: "UnifiedText",
+	}
+	DiffFormat_value = map[string]int32{
+		"Structured":  0,
+		"JsonPatch":   1,
+		"MergePatch":  2,
+		"UnifiedText": 3,
+	}
+)
+
+func (x DiffFormat) Enum() *DiffFormat {
+	p := new(DiffFormat)
+	*p = x
+	return p
+}
+
+func (x DiffFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_kuiper_proto_enumTypes[0].Descriptor()
+}
+
+func (DiffFormat) Type() protoreflect.EnumType {
+	return &file_kuiper_proto_enumTypes[0]
+}
+
+func (x DiffFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffFormat.Descriptor instead.
+func (DiffFormat) EnumDescriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{0}
+}
+
 type ListStandaloneConfigReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -82,6 +134,7 @@ type ListStandaloneConfigResp struct {
 	unknownFields protoimpl.UnknownFields
 
 	Configurations []*StandaloneConfig `protobuf:"bytes,1,rep,name=configurations,proto3" json:"configurations,omitempty"`
+	NextPageToken  string              `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
 func (x *ListStandaloneConfigResp) Reset() {
@@ -123,19 +176,218 @@ func (x *ListStandaloneConfigResp) GetConfigurations() []*StandaloneConfig {
 	return nil
 }
 
+func (x *ListStandaloneConfigResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListOrgConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	PageSize     int32  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken    string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *ListOrgConfigReq) Reset() {
+	*x = ListOrgConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrgConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgConfigReq) ProtoMessage() {}
+
+func (x *ListOrgConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgConfigReq.ProtoReflect.Descriptor instead.
+func (*ListOrgConfigReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListOrgConfigReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ListOrgConfigReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrgConfigReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization    string   `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespaces      []string `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	NamePrefix      string   `protobuf:"bytes,3,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
+	ParamKey        string   `protobuf:"bytes,4,opt,name=paramKey,proto3" json:"paramKey,omitempty"`
+	ParamValue      string   `protobuf:"bytes,5,opt,name=paramValue,proto3" json:"paramValue,omitempty"`
+	ParamValueRegex bool     `protobuf:"varint,6,opt,name=paramValueRegex,proto3" json:"paramValueRegex,omitempty"`
+	CreatedAfter    int64    `protobuf:"varint,7,opt,name=createdAfter,proto3" json:"createdAfter,omitempty"`
+	CreatedBefore   int64    `protobuf:"varint,8,opt,name=createdBefore,proto3" json:"createdBefore,omitempty"`
+	PageSize        int32    `protobuf:"varint,9,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken       string   `protobuf:"bytes,10,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	// configs have to carry every one of the labels
+	Labels map[string]string `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SearchConfigReq) Reset() {
+	*x = SearchConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchConfigReq) ProtoMessage() {}
+
+func (x *SearchConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchConfigReq.ProtoReflect.Descriptor instead.
+func (*SearchConfigReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchConfigReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *SearchConfigReq) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *SearchConfigReq) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *SearchConfigReq) GetParamKey() string {
+	if x != nil {
+		return x.ParamKey
+	}
+	return ""
+}
+
+func (x *SearchConfigReq) GetParamValue() string {
+	if x != nil {
+		return x.ParamValue
+	}
+	return ""
+}
+
+func (x *SearchConfigReq) GetParamValueRegex() bool {
+	if x != nil {
+		return x.ParamValueRegex
+	}
+	return false
+}
+
+func (x *SearchConfigReq) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *SearchConfigReq) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *SearchConfigReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchConfigReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchConfigReq) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 type DiffReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reference *ConfigId `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
-	Diff      *ConfigId `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	Reference *ConfigId  `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Diff      *ConfigId  `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	Format    DiffFormat `protobuf:"varint,3,opt,name=format,proto3,enum=proto.DiffFormat" json:"format,omitempty"`
 }
 
 func (x *DiffReq) Reset() {
 	*x = DiffReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[2]
+		mi := &file_kuiper_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -148,7 +400,7 @@ func (x *DiffReq) String() string {
 func (*DiffReq) ProtoMessage() {}
 
 func (x *DiffReq) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[2]
+	mi := &file_kuiper_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,7 +413,7 @@ func (x *DiffReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffReq.ProtoReflect.Descriptor instead.
 func (*DiffReq) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{2}
+	return file_kuiper_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DiffReq) GetReference() *ConfigId {
@@ -178,18 +430,26 @@ func (x *DiffReq) GetDiff() *ConfigId {
 	return nil
 }
 
+func (x *DiffReq) GetFormat() DiffFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DiffFormat_Structured
+}
+
 type DiffStandaloneConfigResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Diffs []*Diff `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	Patch string  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
 }
 
 func (x *DiffStandaloneConfigResp) Reset() {
 	*x = DiffStandaloneConfigResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[3]
+		mi := &file_kuiper_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -202,7 +462,7 @@ func (x *DiffStandaloneConfigResp) String() string {
 func (*DiffStandaloneConfigResp) ProtoMessage() {}
 
 func (x *DiffStandaloneConfigResp) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[3]
+	mi := &file_kuiper_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +475,7 @@ func (x *DiffStandaloneConfigResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffStandaloneConfigResp.ProtoReflect.Descriptor instead.
 func (*DiffStandaloneConfigResp) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{3}
+	return file_kuiper_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DiffStandaloneConfigResp) GetDiffs() []*Diff {
@@ -225,6 +485,13 @@ func (x *DiffStandaloneConfigResp) GetDiffs() []*Diff {
 	return nil
 }
 
+func (x *DiffStandaloneConfigResp) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
 type ListConfigGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -237,7 +504,7 @@ type ListConfigGroupReq struct {
 func (x *ListConfigGroupReq) Reset() {
 	*x = ListConfigGroupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[4]
+		mi := &file_kuiper_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -250,7 +517,7 @@ func (x *ListConfigGroupReq) String() string {
 func (*ListConfigGroupReq) ProtoMessage() {}
 
 func (x *ListConfigGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[4]
+	mi := &file_kuiper_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,7 +530,7 @@ func (x *ListConfigGroupReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConfigGroupReq.ProtoReflect.Descriptor instead.
 func (*ListConfigGroupReq) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{4}
+	return file_kuiper_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListConfigGroupReq) GetOrganization() string {
@@ -285,13 +552,14 @@ type ListConfigGroupResp struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Groups []*ConfigGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Groups        []*ConfigGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	NextPageToken string         `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
 func (x *ListConfigGroupResp) Reset() {
 	*x = ListConfigGroupResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[5]
+		mi := &file_kuiper_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -304,7 +572,7 @@ func (x *ListConfigGroupResp) String() string {
 func (*ListConfigGroupResp) ProtoMessage() {}
 
 func (x *ListConfigGroupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[5]
+	mi := &file_kuiper_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,7 +585,7 @@ func (x *ListConfigGroupResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConfigGroupResp.ProtoReflect.Descriptor instead.
 func (*ListConfigGroupResp) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{5}
+	return file_kuiper_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListConfigGroupResp) GetGroups() []*ConfigGroup {
@@ -327,18 +595,28 @@ func (x *ListConfigGroupResp) GetGroups() []*ConfigGroup {
 	return nil
 }
 
+func (x *ListConfigGroupResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type DiffConfigGroupResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diffs map[string]*Diffs `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Diffs map[string]*Diffs     `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Patch string                `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	Stats map[string]*DiffStats `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Total *DiffStats            `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
 }
 
 func (x *DiffConfigGroupResp) Reset() {
 	*x = DiffConfigGroupResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[6]
+		mi := &file_kuiper_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -351,7 +629,7 @@ func (x *DiffConfigGroupResp) String() string {
 func (*DiffConfigGroupResp) ProtoMessage() {}
 
 func (x *DiffConfigGroupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[6]
+	mi := &file_kuiper_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +642,7 @@ func (x *DiffConfigGroupResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffConfigGroupResp.ProtoReflect.Descriptor instead.
 func (*DiffConfigGroupResp) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{6}
+	return file_kuiper_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DiffConfigGroupResp) GetDiffs() map[string]*Diffs {
@@ -374,32 +652,57 @@ func (x *DiffConfigGroupResp) GetDiffs() map[string]*Diffs {
 	return nil
 }
 
-type PlaceReq struct {
+func (x *DiffConfigGroupResp) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+func (x *DiffConfigGroupResp) GetStats() map[string]*DiffStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *DiffConfigGroupResp) GetTotal() *DiffStats {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type MergeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config   *ConfigId          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	Strategy *PlaceReq_Strategy `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Base    *ConfigId `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Ours    *ConfigId `protobuf:"bytes,2,opt,name=ours,proto3" json:"ours,omitempty"`
+	Theirs  *ConfigId `protobuf:"bytes,3,opt,name=theirs,proto3" json:"theirs,omitempty"`
+	Save    bool      `protobuf:"varint,4,opt,name=save,proto3" json:"save,omitempty"`
+	Version string    `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	Schema  *Schema   `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
-func (x *PlaceReq) Reset() {
-	*x = PlaceReq{}
+func (x *MergeReq) Reset() {
+	*x = MergeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[7]
+		mi := &file_kuiper_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PlaceReq) String() string {
+func (x *MergeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlaceReq) ProtoMessage() {}
+func (*MergeReq) ProtoMessage() {}
 
-func (x *PlaceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[7]
+func (x *MergeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,409 +713,4696 @@ func (x *PlaceReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlaceReq.ProtoReflect.Descriptor instead.
-func (*PlaceReq) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MergeReq.ProtoReflect.Descriptor instead.
+func (*MergeReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PlaceReq) GetConfig() *ConfigId {
+func (x *MergeReq) GetBase() *ConfigId {
 	if x != nil {
-		return x.Config
+		return x.Base
 	}
 	return nil
 }
 
-func (x *PlaceReq) GetStrategy() *PlaceReq_Strategy {
+func (x *MergeReq) GetOurs() *ConfigId {
 	if x != nil {
-		return x.Strategy
+		return x.Ours
 	}
 	return nil
 }
 
-type PlaceResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Tasks []*PlacementTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+func (x *MergeReq) GetTheirs() *ConfigId {
+	if x != nil {
+		return x.Theirs
+	}
+	return nil
 }
 
-func (x *PlaceResp) Reset() {
-	*x = PlaceResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MergeReq) GetSave() bool {
+	if x != nil {
+		return x.Save
 	}
+	return false
 }
 
-func (x *PlaceResp) String() string {
+func (x *MergeReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *MergeReq) GetSchema() *Schema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type MergeStandaloneConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Merged    *StandaloneConfig `protobuf:"bytes,1,opt,name=merged,proto3" json:"merged,omitempty"`
+	Conflicts []*MergeConflict  `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Saved     bool              `protobuf:"varint,3,opt,name=saved,proto3" json:"saved,omitempty"`
+}
+
+func (x *MergeStandaloneConfigResp) Reset() {
+	*x = MergeStandaloneConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeStandaloneConfigResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlaceResp) ProtoMessage() {}
+func (*MergeStandaloneConfigResp) ProtoMessage() {}
 
-func (x *PlaceResp) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[8]
+func (x *MergeStandaloneConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeStandaloneConfigResp.ProtoReflect.Descriptor instead.
+func (*MergeStandaloneConfigResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MergeStandaloneConfigResp) GetMerged() *StandaloneConfig {
+	if x != nil {
+		return x.Merged
+	}
+	return nil
+}
+
+func (x *MergeStandaloneConfigResp) GetConflicts() []*MergeConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *MergeStandaloneConfigResp) GetSaved() bool {
+	if x != nil {
+		return x.Saved
+	}
+	return false
+}
+
+type MergeConfigGroupResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Merged    *ConfigGroup     `protobuf:"bytes,1,opt,name=merged,proto3" json:"merged,omitempty"`
+	Conflicts []*MergeConflict `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Saved     bool             `protobuf:"varint,3,opt,name=saved,proto3" json:"saved,omitempty"`
+}
+
+func (x *MergeConfigGroupResp) Reset() {
+	*x = MergeConfigGroupResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeConfigGroupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeConfigGroupResp) ProtoMessage() {}
+
+func (x *MergeConfigGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeConfigGroupResp.ProtoReflect.Descriptor instead.
+func (*MergeConfigGroupResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MergeConfigGroupResp) GetMerged() *ConfigGroup {
+	if x != nil {
+		return x.Merged
+	}
+	return nil
+}
+
+func (x *MergeConfigGroupResp) GetConflicts() []*MergeConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *MergeConfigGroupResp) GetSaved() bool {
+	if x != nil {
+		return x.Saved
+	}
+	return false
+}
+
+type PatchOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op       string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	ParamSet string `protobuf:"bytes,2,opt,name=paramSet,proto3" json:"paramSet,omitempty"`
+	Key      string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value    string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *PatchOperation) Reset() {
+	*x = PatchOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchOperation) ProtoMessage() {}
+
+func (x *PatchOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchOperation.ProtoReflect.Descriptor instead.
+func (*PatchOperation) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PatchOperation) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *PatchOperation) GetParamSet() string {
+	if x != nil {
+		return x.ParamSet
+	}
+	return ""
+}
+
+func (x *PatchOperation) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PatchOperation) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type PatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source       *ConfigId         `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Version      string            `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Operations   []*PatchOperation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	JsonPatch    string            `protobuf:"bytes,4,opt,name=jsonPatch,proto3" json:"jsonPatch,omitempty"`
+	ExpectedHash string            `protobuf:"bytes,5,opt,name=expectedHash,proto3" json:"expectedHash,omitempty"`
+	Schema       *Schema           `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (x *PatchReq) Reset() {
+	*x = PatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchReq) ProtoMessage() {}
+
+func (x *PatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchReq.ProtoReflect.Descriptor instead.
+func (*PatchReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PatchReq) GetSource() *ConfigId {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *PatchReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PatchReq) GetOperations() []*PatchOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *PatchReq) GetJsonPatch() string {
+	if x != nil {
+		return x.JsonPatch
+	}
+	return ""
+}
+
+func (x *PatchReq) GetExpectedHash() string {
+	if x != nil {
+		return x.ExpectedHash
+	}
+	return ""
+}
+
+func (x *PatchReq) GetSchema() *Schema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type PlaceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config   *ConfigId          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Strategy *PlaceReq_Strategy `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Rollout  *PlaceReq_Rollout  `protobuf:"bytes,4,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	Rolling  *PlaceReq_Rolling  `protobuf:"bytes,5,opt,name=rolling,proto3" json:"rolling,omitempty"`
+	// seconds agents have to report on their tasks, the server default if unset
+	TimeoutSeconds int64 `protobuf:"varint,6,opt,name=timeoutSeconds,proto3" json:"timeoutSeconds,omitempty"`
+	// only report what the placement would do
+	DryRun bool `protobuf:"varint,7,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	// keep placing the config on nodes the strategy selects later on
+	Persistent bool `protobuf:"varint,8,opt,name=persistent,proto3" json:"persistent,omitempty"`
+	// remove a persistent config from nodes the strategy no longer selects
+	RemoveUnmatched bool `protobuf:"varint,9,opt,name=removeUnmatched,proto3" json:"removeUnmatched,omitempty"`
+	// return once the tasks are stored and deliver the config in the background
+	Async bool `protobuf:"varint,10,opt,name=async,proto3" json:"async,omitempty"`
+}
+
+func (x *PlaceReq) Reset() {
+	*x = PlaceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceReq) ProtoMessage() {}
+
+func (x *PlaceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PlaceResp.ProtoReflect.Descriptor instead.
-func (*PlaceResp) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *PlaceResp) GetTasks() []*PlacementTask {
-	if x != nil {
-		return x.Tasks
-	}
-	return nil
-}
-
-type ListPlacementTaskResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Tasks []*PlacementTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-}
-
-func (x *ListPlacementTaskResp) Reset() {
-	*x = ListPlacementTaskResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListPlacementTaskResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListPlacementTaskResp) ProtoMessage() {}
-
-func (x *ListPlacementTaskResp) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceReq.ProtoReflect.Descriptor instead.
+func (*PlaceReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PlaceReq) GetConfig() *ConfigId {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *PlaceReq) GetStrategy() *PlaceReq_Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return nil
+}
+
+func (x *PlaceReq) GetRollout() *PlaceReq_Rollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+func (x *PlaceReq) GetRolling() *PlaceReq_Rolling {
+	if x != nil {
+		return x.Rolling
+	}
+	return nil
+}
+
+func (x *PlaceReq) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *PlaceReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *PlaceReq) GetPersistent() bool {
+	if x != nil {
+		return x.Persistent
+	}
+	return false
+}
+
+func (x *PlaceReq) GetRemoveUnmatched() bool {
+	if x != nil {
+		return x.RemoveUnmatched
+	}
+	return false
+}
+
+func (x *PlaceReq) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+// narrows down the nodes selected by a strategy
+type PlacementConstraints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// e.g. at most 1 node per rack, applied to every stage of a rollout
+	MaxPerLabel []*PlacementConstraints_MaxPerLabel `protobuf:"bytes,1,rep,name=maxPerLabel,proto3" json:"maxPerLabel,omitempty"`
+	// label whose values the nodes are spread evenly across, taking nodes
+	// from each value in turn
+	SpreadBy     string   `protobuf:"bytes,2,opt,name=spreadBy,proto3" json:"spreadBy,omitempty"`
+	ExcludeNodes []string `protobuf:"bytes,3,rep,name=excludeNodes,proto3" json:"excludeNodes,omitempty"`
+	// configs whose nodes aren't selected
+	AntiAffinity []*PlacementConstraints_ConfigRef `protobuf:"bytes,4,rep,name=antiAffinity,proto3" json:"antiAffinity,omitempty"`
+}
+
+func (x *PlacementConstraints) Reset() {
+	*x = PlacementConstraints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlacementConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementConstraints) ProtoMessage() {}
+
+func (x *PlacementConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementConstraints.ProtoReflect.Descriptor instead.
+func (*PlacementConstraints) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PlacementConstraints) GetMaxPerLabel() []*PlacementConstraints_MaxPerLabel {
+	if x != nil {
+		return x.MaxPerLabel
+	}
+	return nil
+}
+
+func (x *PlacementConstraints) GetSpreadBy() string {
+	if x != nil {
+		return x.SpreadBy
+	}
+	return ""
+}
+
+func (x *PlacementConstraints) GetExcludeNodes() []string {
+	if x != nil {
+		return x.ExcludeNodes
+	}
+	return nil
+}
+
+func (x *PlacementConstraints) GetAntiAffinity() []*PlacementConstraints_ConfigRef {
+	if x != nil {
+		return x.AntiAffinity
+	}
+	return nil
+}
+
+type PlaceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tasks     []*PlacementTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	RolloutId string           `protobuf:"bytes,2,opt,name=rolloutId,proto3" json:"rolloutId,omitempty"`
+	// set instead of tasks for dry runs
+	Plan []*PlacementPlan `protobuf:"bytes,3,rep,name=plan,proto3" json:"plan,omitempty"`
+	// constraints the selected nodes couldn't satisfy
+	Violations []*ConstraintViolation `protobuf:"bytes,4,rep,name=violations,proto3" json:"violations,omitempty"`
+	// groups the tasks created by this placement
+	PlacementId string `protobuf:"bytes,5,opt,name=placementId,proto3" json:"placementId,omitempty"`
+}
+
+func (x *PlaceResp) Reset() {
+	*x = PlaceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceResp) ProtoMessage() {}
+
+func (x *PlaceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceResp.ProtoReflect.Descriptor instead.
+func (*PlaceResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PlaceResp) GetTasks() []*PlacementTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *PlaceResp) GetRolloutId() string {
+	if x != nil {
+		return x.RolloutId
+	}
+	return ""
+}
+
+func (x *PlaceResp) GetPlan() []*PlacementPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *PlaceResp) GetViolations() []*ConstraintViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *PlaceResp) GetPlacementId() string {
+	if x != nil {
+		return x.PlacementId
+	}
+	return ""
+}
+
+// field numbers match ConfigId
+type ListPlacementTaskReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string   `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version      string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Namespace    string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Statuses     []string `protobuf:"bytes,5,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	// only list the tasks of the placement with this id
+	PlacementId string `protobuf:"bytes,6,opt,name=placementId,proto3" json:"placementId,omitempty"`
+}
+
+func (x *ListPlacementTaskReq) Reset() {
+	*x = ListPlacementTaskReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlacementTaskReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlacementTaskReq) ProtoMessage() {}
+
+func (x *ListPlacementTaskReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlacementTaskReq.ProtoReflect.Descriptor instead.
+func (*ListPlacementTaskReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListPlacementTaskReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ListPlacementTaskReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListPlacementTaskReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ListPlacementTaskReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListPlacementTaskReq) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListPlacementTaskReq) GetPlacementId() string {
+	if x != nil {
+		return x.PlacementId
+	}
+	return ""
+}
+
+type WatchPlacementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version      string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Namespace    string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// tasks to watch, all tasks of the config if empty
+	TaskIds []string `protobuf:"bytes,5,rep,name=taskIds,proto3" json:"taskIds,omitempty"`
+	// how long to wait for the tasks to resolve, the placement timeout if zero
+	TimeoutSeconds int64 `protobuf:"varint,6,opt,name=timeoutSeconds,proto3" json:"timeoutSeconds,omitempty"`
+	// only watch the tasks of the placement with this id
+	PlacementId string `protobuf:"bytes,7,opt,name=placementId,proto3" json:"placementId,omitempty"`
+}
+
+func (x *WatchPlacementReq) Reset() {
+	*x = WatchPlacementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPlacementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPlacementReq) ProtoMessage() {}
+
+func (x *WatchPlacementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPlacementReq.ProtoReflect.Descriptor instead.
+func (*WatchPlacementReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WatchPlacementReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *WatchPlacementReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchPlacementReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *WatchPlacementReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WatchPlacementReq) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *WatchPlacementReq) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *WatchPlacementReq) GetPlacementId() string {
+	if x != nil {
+		return x.PlacementId
+	}
+	return ""
+}
+
+type PlacementSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// number of tasks per status
+	Statuses map[string]int32 `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// false if the watch timed out before every task was resolved
+	Resolved bool `protobuf:"varint,3,opt,name=resolved,proto3" json:"resolved,omitempty"`
+}
+
+func (x *PlacementSummary) Reset() {
+	*x = PlacementSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlacementSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementSummary) ProtoMessage() {}
+
+func (x *PlacementSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementSummary.ProtoReflect.Descriptor instead.
+func (*PlacementSummary) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PlacementSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PlacementSummary) GetStatuses() map[string]int32 {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *PlacementSummary) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+// PlacementEvent is either a task that changed or, as the last event of the
+// stream, the summary of the placement.
+type PlacementEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*PlacementEvent_Task
+	//	*PlacementEvent_Summary
+	Event isPlacementEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *PlacementEvent) Reset() {
+	*x = PlacementEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlacementEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementEvent) ProtoMessage() {}
+
+func (x *PlacementEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementEvent.ProtoReflect.Descriptor instead.
+func (*PlacementEvent) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{20}
+}
+
+func (m *PlacementEvent) GetEvent() isPlacementEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *PlacementEvent) GetTask() *PlacementTask {
+	if x, ok := x.GetEvent().(*PlacementEvent_Task); ok {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *PlacementEvent) GetSummary() *PlacementSummary {
+	if x, ok := x.GetEvent().(*PlacementEvent_Summary); ok {
+		return x.Summary
+	}
+	return nil
+}
+
+type isPlacementEvent_Event interface {
+	isPlacementEvent_Event()
+}
+
+type PlacementEvent_Task struct {
+	Task *PlacementTask `protobuf:"bytes,1,opt,name=task,proto3,oneof"`
+}
+
+type PlacementEvent_Summary struct {
+	Summary *PlacementSummary `protobuf:"bytes,2,opt,name=summary,proto3,oneof"`
+}
+
+func (*PlacementEvent_Task) isPlacementEvent_Event() {}
+
+func (*PlacementEvent_Summary) isPlacementEvent_Event() {}
+
+type CancelPlacementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version      string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Namespace    string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// tasks to cancel, all unacknowledged tasks of the config if empty
+	TaskIds []string `protobuf:"bytes,5,rep,name=taskIds,proto3" json:"taskIds,omitempty"`
+	// only cancel the tasks of the placement with this id
+	PlacementId string `protobuf:"bytes,6,opt,name=placementId,proto3" json:"placementId,omitempty"`
+}
+
+func (x *CancelPlacementReq) Reset() {
+	*x = CancelPlacementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPlacementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPlacementReq) ProtoMessage() {}
+
+func (x *CancelPlacementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPlacementReq.ProtoReflect.Descriptor instead.
+func (*CancelPlacementReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CancelPlacementReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *CancelPlacementReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CancelPlacementReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *CancelPlacementReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CancelPlacementReq) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *CancelPlacementReq) GetPlacementId() string {
+	if x != nil {
+		return x.PlacementId
+	}
+	return ""
+}
+
+type CancelPlacementResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cancelled []*PlacementTask `protobuf:"bytes,1,rep,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// rollouts of the config that were aborted
+	AbortedRollouts []string `protobuf:"bytes,2,rep,name=abortedRollouts,proto3" json:"abortedRollouts,omitempty"`
+	// pending scheduled placements of the config that were cancelled
+	CancelledSchedules []string `protobuf:"bytes,3,rep,name=cancelledSchedules,proto3" json:"cancelledSchedules,omitempty"`
+	// whether the persistent placement of the config's version was deleted
+	PersistentDeleted bool `protobuf:"varint,4,opt,name=persistentDeleted,proto3" json:"persistentDeleted,omitempty"`
+}
+
+func (x *CancelPlacementResp) Reset() {
+	*x = CancelPlacementResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPlacementResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPlacementResp) ProtoMessage() {}
+
+func (x *CancelPlacementResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPlacementResp.ProtoReflect.Descriptor instead.
+func (*CancelPlacementResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CancelPlacementResp) GetCancelled() []*PlacementTask {
+	if x != nil {
+		return x.Cancelled
+	}
+	return nil
+}
+
+func (x *CancelPlacementResp) GetAbortedRollouts() []string {
+	if x != nil {
+		return x.AbortedRollouts
+	}
+	return nil
+}
+
+func (x *CancelPlacementResp) GetCancelledSchedules() []string {
+	if x != nil {
+		return x.CancelledSchedules
+	}
+	return nil
+}
+
+func (x *CancelPlacementResp) GetPersistentDeleted() bool {
+	if x != nil {
+		return x.PersistentDeleted
+	}
+	return false
+}
+
+type ListPlacementTaskResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tasks []*PlacementTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+}
+
+func (x *ListPlacementTaskResp) Reset() {
+	*x = ListPlacementTaskResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlacementTaskResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlacementTaskResp) ProtoMessage() {}
+
+func (x *ListPlacementTaskResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlacementTaskResp.ProtoReflect.Descriptor instead.
+func (*ListPlacementTaskResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListPlacementTaskResp) GetTasks() []*PlacementTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type ListPlacementsByNodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string   `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Node         string   `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Statuses     []string `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	PageSize     int32    `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken    string   `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *ListPlacementsByNodeReq) Reset() {
+	*x = ListPlacementsByNodeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlacementsByNodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlacementsByNodeReq) ProtoMessage() {}
+
+func (x *ListPlacementsByNodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlacementsByNodeReq.ProtoReflect.Descriptor instead.
+func (*ListPlacementsByNodeReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListPlacementsByNodeReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ListPlacementsByNodeReq) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListPlacementsByNodeReq) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListPlacementsByNodeReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPlacementsByNodeReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPlacementsByNodeResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Placements    []*NodePlacement `protobuf:"bytes,1,rep,name=placements,proto3" json:"placements,omitempty"`
+	NextPageToken string           `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *ListPlacementsByNodeResp) Reset() {
+	*x = ListPlacementsByNodeResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlacementsByNodeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlacementsByNodeResp) ProtoMessage() {}
+
+func (x *ListPlacementsByNodeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlacementsByNodeResp.ProtoReflect.Descriptor instead.
+func (*ListPlacementsByNodeResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPlacementsByNodeResp) GetPlacements() []*NodePlacement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *ListPlacementsByNodeResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListDriftReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Node         string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *ListDriftReq) Reset() {
+	*x = ListDriftReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDriftReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriftReq) ProtoMessage() {}
+
+func (x *ListDriftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriftReq.ProtoReflect.Descriptor instead.
+func (*ListDriftReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListDriftReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ListDriftReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListDriftReq) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type ListDriftResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Drifts []*ConfigDrift `protobuf:"bytes,1,rep,name=drifts,proto3" json:"drifts,omitempty"`
+}
+
+func (x *ListDriftResp) Reset() {
+	*x = ListDriftResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDriftResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriftResp) ProtoMessage() {}
+
+func (x *ListDriftResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriftResp.ProtoReflect.Descriptor instead.
+func (*ListDriftResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListDriftResp) GetDrifts() []*ConfigDrift {
+	if x != nil {
+		return x.Drifts
+	}
+	return nil
+}
+
+type GroupParamSetId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group    *ConfigId `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	ParamSet string    `protobuf:"bytes,2,opt,name=paramSet,proto3" json:"paramSet,omitempty"`
+}
+
+func (x *GroupParamSetId) Reset() {
+	*x = GroupParamSetId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupParamSetId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupParamSetId) ProtoMessage() {}
+
+func (x *GroupParamSetId) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupParamSetId.ProtoReflect.Descriptor instead.
+func (*GroupParamSetId) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GroupParamSetId) GetGroup() *ConfigId {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *GroupParamSetId) GetParamSet() string {
+	if x != nil {
+		return x.ParamSet
+	}
+	return ""
+}
+
+type DiffSide struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Source:
+	//	*DiffSide_Standalone
+	//	*DiffSide_Group
+	//	*DiffSide_Inline
+	Source isDiffSide_Source `protobuf_oneof:"source"`
+}
+
+func (x *DiffSide) Reset() {
+	*x = DiffSide{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffSide) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSide) ProtoMessage() {}
+
+func (x *DiffSide) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSide.ProtoReflect.Descriptor instead.
+func (*DiffSide) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{29}
+}
+
+func (m *DiffSide) GetSource() isDiffSide_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *DiffSide) GetStandalone() *ConfigId {
+	if x, ok := x.GetSource().(*DiffSide_Standalone); ok {
+		return x.Standalone
+	}
+	return nil
+}
+
+func (x *DiffSide) GetGroup() *GroupParamSetId {
+	if x, ok := x.GetSource().(*DiffSide_Group); ok {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *DiffSide) GetInline() *NamedParamSet {
+	if x, ok := x.GetSource().(*DiffSide_Inline); ok {
+		return x.Inline
+	}
+	return nil
+}
+
+type isDiffSide_Source interface {
+	isDiffSide_Source()
+}
+
+type DiffSide_Standalone struct {
+	Standalone *ConfigId `protobuf:"bytes,1,opt,name=standalone,proto3,oneof"`
+}
+
+type DiffSide_Group struct {
+	Group *GroupParamSetId `protobuf:"bytes,2,opt,name=group,proto3,oneof"`
+}
+
+type DiffSide_Inline struct {
+	Inline *NamedParamSet `protobuf:"bytes,3,opt,name=inline,proto3,oneof"`
+}
+
+func (*DiffSide_Standalone) isDiffSide_Source() {}
+
+func (*DiffSide_Group) isDiffSide_Source() {}
+
+func (*DiffSide_Inline) isDiffSide_Source() {}
+
+type DiffParamSetsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference *DiffSide  `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Diff      *DiffSide  `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	Format    DiffFormat `protobuf:"varint,3,opt,name=format,proto3,enum=proto.DiffFormat" json:"format,omitempty"`
+}
+
+func (x *DiffParamSetsReq) Reset() {
+	*x = DiffParamSetsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffParamSetsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffParamSetsReq) ProtoMessage() {}
+
+func (x *DiffParamSetsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffParamSetsReq.ProtoReflect.Descriptor instead.
+func (*DiffParamSetsReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DiffParamSetsReq) GetReference() *DiffSide {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *DiffParamSetsReq) GetDiff() *DiffSide {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *DiffParamSetsReq) GetFormat() DiffFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DiffFormat_Structured
+}
+
+type DiffParamSetsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diffs []*Diff    `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	Patch string     `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	Stats *DiffStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *DiffParamSetsResp) Reset() {
+	*x = DiffParamSetsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffParamSetsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffParamSetsResp) ProtoMessage() {}
+
+func (x *DiffParamSetsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffParamSetsResp.ProtoReflect.Descriptor instead.
+func (*DiffParamSetsResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DiffParamSetsResp) GetDiffs() []*Diff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+func (x *DiffParamSetsResp) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+func (x *DiffParamSetsResp) GetStats() *DiffStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ListPlacementStrategiesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPlacementStrategiesReq) Reset() {
+	*x = ListPlacementStrategiesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlacementStrategiesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlacementStrategiesReq) ProtoMessage() {}
+
+func (x *ListPlacementStrategiesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlacementStrategiesReq.ProtoReflect.Descriptor instead.
+func (*ListPlacementStrategiesReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{32}
+}
+
+type PlacementStrategyParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Required    bool   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (x *PlacementStrategyParam) Reset() {
+	*x = PlacementStrategyParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlacementStrategyParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementStrategyParam) ProtoMessage() {}
+
+func (x *PlacementStrategyParam) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementStrategyParam.ProtoReflect.Descriptor instead.
+func (*PlacementStrategyParam) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PlacementStrategyParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlacementStrategyParam) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PlacementStrategyParam) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlacementStrategyParam) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type PlacementStrategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Params      []*PlacementStrategyParam `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *PlacementStrategy) Reset() {
+	*x = PlacementStrategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlacementStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementStrategy) ProtoMessage() {}
+
+func (x *PlacementStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementStrategy.ProtoReflect.Descriptor instead.
+func (*PlacementStrategy) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PlacementStrategy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlacementStrategy) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlacementStrategy) GetParams() []*PlacementStrategyParam {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type ListPlacementStrategiesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strategies []*PlacementStrategy `protobuf:"bytes,1,rep,name=strategies,proto3" json:"strategies,omitempty"`
+}
+
+func (x *ListPlacementStrategiesResp) Reset() {
+	*x = ListPlacementStrategiesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlacementStrategiesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlacementStrategiesResp) ProtoMessage() {}
+
+func (x *ListPlacementStrategiesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlacementStrategiesResp.ProtoReflect.Descriptor instead.
+func (*ListPlacementStrategiesResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListPlacementStrategiesResp) GetStrategies() []*PlacementStrategy {
+	if x != nil {
+		return x.Strategies
+	}
+	return nil
+}
+
+type RolloutId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Id           string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RolloutId) Reset() {
+	*x = RolloutId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutId) ProtoMessage() {}
+
+func (x *RolloutId) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutId.ProtoReflect.Descriptor instead.
+func (*RolloutId) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RolloutId) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *RolloutId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PromoteRolloutReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Id           string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// skip the health gates of all remaining stages
+	Full bool `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
+}
+
+func (x *PromoteRolloutReq) Reset() {
+	*x = PromoteRolloutReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteRolloutReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteRolloutReq) ProtoMessage() {}
+
+func (x *PromoteRolloutReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteRolloutReq.ProtoReflect.Descriptor instead.
+func (*PromoteRolloutReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PromoteRolloutReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *PromoteRolloutReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PromoteRolloutReq) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+type ListRolloutsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ActiveOnly   bool   `protobuf:"varint,3,opt,name=activeOnly,proto3" json:"activeOnly,omitempty"`
+}
+
+func (x *ListRolloutsReq) Reset() {
+	*x = ListRolloutsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRolloutsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolloutsReq) ProtoMessage() {}
+
+func (x *ListRolloutsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolloutsReq.ProtoReflect.Descriptor instead.
+func (*ListRolloutsReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListRolloutsReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ListRolloutsReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListRolloutsReq) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListRolloutsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rollouts []*Rollout `protobuf:"bytes,1,rep,name=rollouts,proto3" json:"rollouts,omitempty"`
+}
+
+func (x *ListRolloutsResp) Reset() {
+	*x = ListRolloutsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRolloutsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolloutsResp) ProtoMessage() {}
+
+func (x *ListRolloutsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolloutsResp.ProtoReflect.Descriptor instead.
+func (*ListRolloutsResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListRolloutsResp) GetRollouts() []*Rollout {
+	if x != nil {
+		return x.Rollouts
+	}
+	return nil
+}
+
+type RollbackResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rollbacks []*RollbackPlacement `protobuf:"bytes,1,rep,name=rollbacks,proto3" json:"rollbacks,omitempty"`
+	// nodes that had no earlier version to restore
+	SkippedNodes []string `protobuf:"bytes,2,rep,name=skippedNodes,proto3" json:"skippedNodes,omitempty"`
+}
+
+func (x *RollbackResp) Reset() {
+	*x = RollbackResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackResp) ProtoMessage() {}
+
+func (x *RollbackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackResp.ProtoReflect.Descriptor instead.
+func (*RollbackResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RollbackResp) GetRollbacks() []*RollbackPlacement {
+	if x != nil {
+		return x.Rollbacks
+	}
+	return nil
+}
+
+func (x *RollbackResp) GetSkippedNodes() []string {
+	if x != nil {
+		return x.SkippedNodes
+	}
+	return nil
+}
+
+type MaintenanceWindowId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *MaintenanceWindowId) Reset() {
+	*x = MaintenanceWindowId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceWindowId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindowId) ProtoMessage() {}
+
+func (x *MaintenanceWindowId) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindowId.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindowId) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MaintenanceWindowId) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *MaintenanceWindowId) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *MaintenanceWindowId) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListMaintenanceWindowsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	// windows of the org that apply to the namespace, all of them if empty
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *ListMaintenanceWindowsReq) Reset() {
+	*x = ListMaintenanceWindowsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMaintenanceWindowsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsReq) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsReq.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListMaintenanceWindowsReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ListMaintenanceWindowsReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListMaintenanceWindowsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Windows []*MaintenanceWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *ListMaintenanceWindowsResp) Reset() {
+	*x = ListMaintenanceWindowsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMaintenanceWindowsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsResp) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsResp.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListMaintenanceWindowsResp) GetWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type SchedulePlacementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Placement *PlaceReq `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	// unix time to place the config at
+	StartAt int64 `protobuf:"varint,2,opt,name=startAt,proto3" json:"startAt,omitempty"`
+}
+
+func (x *SchedulePlacementReq) Reset() {
+	*x = SchedulePlacementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulePlacementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePlacementReq) ProtoMessage() {}
+
+func (x *SchedulePlacementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePlacementReq.ProtoReflect.Descriptor instead.
+func (*SchedulePlacementReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SchedulePlacementReq) GetPlacement() *PlaceReq {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *SchedulePlacementReq) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+type ScheduledPlacementId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Id           string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ScheduledPlacementId) Reset() {
+	*x = ScheduledPlacementId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledPlacementId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPlacementId) ProtoMessage() {}
+
+func (x *ScheduledPlacementId) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPlacementId.ProtoReflect.Descriptor instead.
+func (*ScheduledPlacementId) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ScheduledPlacementId) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ScheduledPlacementId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListScheduledPlacementsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *ListScheduledPlacementsReq) Reset() {
+	*x = ListScheduledPlacementsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledPlacementsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledPlacementsReq) ProtoMessage() {}
+
+func (x *ListScheduledPlacementsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledPlacementsReq.ProtoReflect.Descriptor instead.
+func (*ListScheduledPlacementsReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListScheduledPlacementsReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ListScheduledPlacementsReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListScheduledPlacementsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Placements []*ScheduledPlacement `protobuf:"bytes,1,rep,name=placements,proto3" json:"placements,omitempty"`
+}
+
+func (x *ListScheduledPlacementsResp) Reset() {
+	*x = ListScheduledPlacementsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledPlacementsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledPlacementsResp) ProtoMessage() {}
+
+func (x *ListScheduledPlacementsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledPlacementsResp.ProtoReflect.Descriptor instead.
+func (*ListScheduledPlacementsResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListScheduledPlacementsResp) GetPlacements() []*ScheduledPlacement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+type PersistentPlacement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type            string             `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Config          *ConfigId          `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Strategy        *PlaceReq_Strategy `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	TimeoutSeconds  int64              `protobuf:"varint,4,opt,name=timeoutSeconds,proto3" json:"timeoutSeconds,omitempty"`
+	RemoveUnmatched bool               `protobuf:"varint,5,opt,name=removeUnmatched,proto3" json:"removeUnmatched,omitempty"`
+	CreatedAt       string             `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *PersistentPlacement) Reset() {
+	*x = PersistentPlacement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistentPlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistentPlacement) ProtoMessage() {}
+
+func (x *PersistentPlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistentPlacement.ProtoReflect.Descriptor instead.
+func (*PersistentPlacement) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PersistentPlacement) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PersistentPlacement) GetConfig() *ConfigId {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *PersistentPlacement) GetStrategy() *PlaceReq_Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return nil
+}
+
+func (x *PersistentPlacement) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *PersistentPlacement) GetRemoveUnmatched() bool {
+	if x != nil {
+		return x.RemoveUnmatched
+	}
+	return false
+}
+
+func (x *PersistentPlacement) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type PersistentPlacementId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Type         string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Namespace    string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name         string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PersistentPlacementId) Reset() {
+	*x = PersistentPlacementId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistentPlacementId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistentPlacementId) ProtoMessage() {}
+
+func (x *PersistentPlacementId) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistentPlacementId.ProtoReflect.Descriptor instead.
+func (*PersistentPlacementId) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PersistentPlacementId) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *PersistentPlacementId) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PersistentPlacementId) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PersistentPlacementId) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListPersistentPlacementsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *ListPersistentPlacementsReq) Reset() {
+	*x = ListPersistentPlacementsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPersistentPlacementsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPersistentPlacementsReq) ProtoMessage() {}
+
+func (x *ListPersistentPlacementsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPersistentPlacementsReq.ProtoReflect.Descriptor instead.
+func (*ListPersistentPlacementsReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListPersistentPlacementsReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ListPersistentPlacementsReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListPersistentPlacementsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Placements []*PersistentPlacement `protobuf:"bytes,1,rep,name=placements,proto3" json:"placements,omitempty"`
+}
+
+func (x *ListPersistentPlacementsResp) Reset() {
+	*x = ListPersistentPlacementsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPersistentPlacementsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPersistentPlacementsResp) ProtoMessage() {}
+
+func (x *ListPersistentPlacementsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPersistentPlacementsResp.ProtoReflect.Descriptor instead.
+func (*ListPersistentPlacementsResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListPersistentPlacementsResp) GetPlacements() []*PersistentPlacement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+type PlaceReq_Strategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Query      []*api.Selector `protobuf:"bytes,2,rep,name=query,proto3" json:"query,omitempty"`
+	Percentage int32           `protobuf:"varint,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// parameters of strategies that don't have a dedicated field
+	Params map[string]string `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// changes which nodes the gossip strategy picks for the same config
+	Seed        string                `protobuf:"bytes,5,opt,name=seed,proto3" json:"seed,omitempty"`
+	Constraints *PlacementConstraints `protobuf:"bytes,6,opt,name=constraints,proto3" json:"constraints,omitempty"`
+}
+
+func (x *PlaceReq_Strategy) Reset() {
+	*x = PlaceReq_Strategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceReq_Strategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceReq_Strategy) ProtoMessage() {}
+
+func (x *PlaceReq_Strategy) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceReq_Strategy.ProtoReflect.Descriptor instead.
+func (*PlaceReq_Strategy) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *PlaceReq_Strategy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlaceReq_Strategy) GetQuery() []*api.Selector {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *PlaceReq_Strategy) GetPercentage() int32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *PlaceReq_Strategy) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *PlaceReq_Strategy) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
+func (x *PlaceReq_Strategy) GetConstraints() *PlacementConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+type PlaceReq_Rollout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cumulative percentages of the selected nodes, defaults to 1, 10, 50, 100
+	Stages []int32 `protobuf:"varint,1,rep,packed,name=stages,proto3" json:"stages,omitempty"`
+	// share of failed tasks in a stage above which the rollout is paused,
+	// defaults to 0.1 when unset or 0
+	MaxFailureRatio float64 `protobuf:"fixed64,2,opt,name=maxFailureRatio,proto3" json:"maxFailureRatio,omitempty"`
+}
+
+func (x *PlaceReq_Rollout) Reset() {
+	*x = PlaceReq_Rollout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceReq_Rollout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceReq_Rollout) ProtoMessage() {}
+
+func (x *PlaceReq_Rollout) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceReq_Rollout.ProtoReflect.Descriptor instead.
+func (*PlaceReq_Rollout) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{14, 1}
+}
+
+func (x *PlaceReq_Rollout) GetStages() []int32 {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *PlaceReq_Rollout) GetMaxFailureRatio() float64 {
+	if x != nil {
+		return x.MaxFailureRatio
+	}
+	return 0
+}
+
+type PlaceReq_Rolling struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Batch:
+	//	*PlaceReq_Rolling_BatchSize
+	//	*PlaceReq_Rolling_BatchPercentage
+	Batch isPlaceReq_Rolling_Batch `protobuf_oneof:"batch"`
+	// failed tasks tolerated across all batches before the rollout stops
+	MaxFailures int32 `protobuf:"varint,3,opt,name=maxFailures,proto3" json:"maxFailures,omitempty"`
+}
+
+func (x *PlaceReq_Rolling) Reset() {
+	*x = PlaceReq_Rolling{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceReq_Rolling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceReq_Rolling) ProtoMessage() {}
+
+func (x *PlaceReq_Rolling) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceReq_Rolling.ProtoReflect.Descriptor instead.
+func (*PlaceReq_Rolling) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{14, 2}
+}
+
+func (m *PlaceReq_Rolling) GetBatch() isPlaceReq_Rolling_Batch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (x *PlaceReq_Rolling) GetBatchSize() int32 {
+	if x, ok := x.GetBatch().(*PlaceReq_Rolling_BatchSize); ok {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *PlaceReq_Rolling) GetBatchPercentage() int32 {
+	if x, ok := x.GetBatch().(*PlaceReq_Rolling_BatchPercentage); ok {
+		return x.BatchPercentage
+	}
+	return 0
+}
+
+func (x *PlaceReq_Rolling) GetMaxFailures() int32 {
+	if x != nil {
+		return x.MaxFailures
+	}
+	return 0
+}
+
+type isPlaceReq_Rolling_Batch interface {
+	isPlaceReq_Rolling_Batch()
+}
+
+type PlaceReq_Rolling_BatchSize struct {
+	BatchSize int32 `protobuf:"varint,1,opt,name=batchSize,proto3,oneof"`
+}
+
+type PlaceReq_Rolling_BatchPercentage struct {
+	BatchPercentage int32 `protobuf:"varint,2,opt,name=batchPercentage,proto3,oneof"`
+}
+
+func (*PlaceReq_Rolling_BatchSize) isPlaceReq_Rolling_Batch() {}
+
+func (*PlaceReq_Rolling_BatchPercentage) isPlaceReq_Rolling_Batch() {}
+
+type PlacementConstraints_MaxPerLabel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Max   int32  `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *PlacementConstraints_MaxPerLabel) Reset() {
+	*x = PlacementConstraints_MaxPerLabel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlacementConstraints_MaxPerLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementConstraints_MaxPerLabel) ProtoMessage() {}
+
+func (x *PlacementConstraints_MaxPerLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementConstraints_MaxPerLabel.ProtoReflect.Descriptor instead.
+func (*PlacementConstraints_MaxPerLabel) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *PlacementConstraints_MaxPerLabel) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PlacementConstraints_MaxPerLabel) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type PlacementConstraints_ConfigRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PlacementConstraints_ConfigRef) Reset() {
+	*x = PlacementConstraints_ConfigRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlacementConstraints_ConfigRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementConstraints_ConfigRef) ProtoMessage() {}
+
+func (x *PlacementConstraints_ConfigRef) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementConstraints_ConfigRef.ProtoReflect.Descriptor instead.
+func (*PlacementConstraints_ConfigRef) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *PlacementConstraints_ConfigRef) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PlacementConstraints_ConfigRef) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PlacementConstraints_ConfigRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_kuiper_proto protoreflect.FileDescriptor
+
+var file_kuiper_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x6b, 0x75, 0x69, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x6b, 0x75, 0x69, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x6d, 0x61, 0x67, 0x6e, 0x65,
+	0x74, 0x61, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5b, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x70, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x72, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x22,
+	0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd6, 0x03, 0x0a,
+	0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x4b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x4b, 0x65, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65,
+	0x67, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x24,
+	0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a,
+	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x88, 0x01, 0x0a, 0x07, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65,
+	0x71, 0x12, 0x2d, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x23, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x22, 0x53, 0x0a, 0x18, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f,
+	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x05,
+	0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x22, 0x56, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x67, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe1, 0x02, 0x0a, 0x13, 0x44, 0x69, 0x66, 0x66, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3b,
+	0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x46, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4a,
+	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd2, 0x01, 0x0a, 0x08, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04,
+	0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x04, 0x6f, 0x75, 0x72,
+	0x73, 0x12, 0x27, 0x0a, 0x06, 0x74, 0x68, 0x65, 0x69, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x64, 0x52, 0x06, 0x74, 0x68, 0x65, 0x69, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61,
+	0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x61, 0x76, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22,
+	0x96, 0x01, 0x0a, 0x19, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c,
+	0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a,
+	0x06, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x61, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x73, 0x61, 0x76, 0x65, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x2a, 0x0a, 0x06, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x12, 0x32, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x61, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x73, 0x61, 0x76, 0x65, 0x64, 0x22, 0x64, 0x0a, 0x0e, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x53, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x53, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xed, 0x01,
+	0x0a, 0x08, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xf3, 0x06,
+	0x0a, 0x08, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
+	0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x52, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x31, 0x0a, 0x07,
+	0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12,
+	0x26, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x79,
+	0x6e, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x1a,
+	0xb1, 0x02, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a, 0x07, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x1a, 0x80, 0x01, 0x0a, 0x07, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2a, 0x0a, 0x0f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d,
+	0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x22, 0xf6, 0x02, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x4d,
+	0x61, 0x78, 0x50, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x50,
+	0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x72, 0x65, 0x61,
+	0x64, 0x42, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x70, 0x72, 0x65, 0x61,
+	0x64, 0x42, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0c, 0x61, 0x6e, 0x74, 0x69, 0x41,
+	0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x66, 0x52, 0x0c, 0x61, 0x6e, 0x74, 0x69, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x79, 0x1a, 0x35, 0x0a, 0x0b, 0x4d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x1a, 0x51, 0x0a, 0x09, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xdd, 0x01, 0x0a,
+	0x09, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x05, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x3a,
+	0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xc4, 0x01, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0xe7, 0x01, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x73,
+	0x6b, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b,
+	0x49, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xc4, 0x01,
+	0x0a, 0x10, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x1a, 0x3b, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x7a, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x74, 0x61,
+	0x73, 0x6b, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0xc0, 0x01, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0xd1, 0x01, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x09, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12,
+	0x28, 0x0a, 0x0f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x43, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x2a, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0xa7, 0x01, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42,
+	0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x76, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x64,
+	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x22,
+	0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x22, 0x3b, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x69, 0x66,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x66, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x69, 0x66, 0x74,
+	0x73, 0x22, 0x54, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x53,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x49, 0x64, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x08, 0x44, 0x69, 0x66, 0x66,
+	0x53, 0x69, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x49, 0x64, 0x48, 0x00,
+	0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2e, 0x0a, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x48, 0x00, 0x52,
+	0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0x91, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x66, 0x66, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x53,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x69, 0x64, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x74, 0x0a, 0x11, 0x44, 0x69, 0x66, 0x66, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x05, 0x64, 0x69,
+	0x66, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22, 0x7e, 0x0a, 0x16, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x11, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x57, 0x0a, 0x1b,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x0a, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x69, 0x65, 0x73, 0x22, 0x3f, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5b, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66,
+	0x75, 0x6c, 0x6c, 0x22, 0x73, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x3e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x08,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x08,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x22, 0x6a, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x22, 0x6b, 0x0a, 0x13, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x5d, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x12, 0x22,
+	0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x22, 0x50, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32,
+	0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x22, 0x5f, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x09, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x52, 0x09,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x5e, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a,
+	0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22,
+	0x58, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39,
+	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xf8, 0x01, 0x0a, 0x13, 0x50, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34,
+	0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x5a, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x4c, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x64, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x54, 0x65, 0x78,
+	0x74, 0x10, 0x03, 0x32, 0xf9, 0x1b, 0x0a, 0x06, 0x4b, 0x75, 0x69, 0x70, 0x65, 0x72, 0x12, 0x4c,
+	0x0a, 0x13, 0x50, 0x75, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65,
+	0x77, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61,
+	0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x49, 0x64, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12,
+	0x59, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x72, 0x67, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x72, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x53, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
+	0x64, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61,
+	0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x15,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x23, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x42,
+	0x79, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x55,
+	0x0a, 0x1e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x1f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x42, 0x0a, 0x18, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x13,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x14, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61,
+	0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x15, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c,
+	0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f,
+	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43,
+	0x0a, 0x15, 0x50, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65,
+	0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x12, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x22, 0x00, 0x12, 0x37, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x72, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x10, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x19, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x13,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x49, 0x64, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x44,
+	0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x1a, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x10,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x39, 0x0a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72,
+	0x69, 0x66, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x44, 0x0a, 0x0d, 0x44, 0x69, 0x66, 0x66, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x74,
+	0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65,
+	0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x32, 0x0a, 0x0c, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49,
+	0x64, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0c, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x14, 0x50, 0x75,
+	0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x1a, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x64, 0x1a,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x21,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f,
+	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x1c, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x18, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12,
+	0x65, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kuiper_proto_rawDescOnce sync.Once
+	file_kuiper_proto_rawDescData = file_kuiper_proto_rawDesc
+)
+
+func file_kuiper_proto_rawDescGZIP() []byte {
+	file_kuiper_proto_rawDescOnce.Do(func() {
+		file_kuiper_proto_rawDescData = protoimpl.X.CompressGZIP(file_kuiper_proto_rawDescData)
+	})
+	return file_kuiper_proto_rawDescData
+}
+
+var file_kuiper_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_kuiper_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
+var file_kuiper_proto_goTypes = []interface{}{
+	(DiffFormat)(0),                          // 0: proto.DiffFormat
+	(*ListStandaloneConfigReq)(nil),          // 1: proto.ListStandaloneConfigReq
+	(*ListStandaloneConfigResp)(nil),         // 2: proto.ListStandaloneConfigResp
+	(*ListOrgConfigReq)(nil),                 // 3: proto.ListOrgConfigReq
+	(*SearchConfigReq)(nil),                  // 4: proto.SearchConfigReq
+	(*DiffReq)(nil),                          // 5: proto.DiffReq
+	(*DiffStandaloneConfigResp)(nil),         // 6: proto.DiffStandaloneConfigResp
+	(*ListConfigGroupReq)(nil),               // 7: proto.ListConfigGroupReq
+	(*ListConfigGroupResp)(nil),              // 8: proto.ListConfigGroupResp
+	(*DiffConfigGroupResp)(nil),              // 9: proto.DiffConfigGroupResp
+	(*MergeReq)(nil),                         // 10: proto.MergeReq
+	(*MergeStandaloneConfigResp)(nil),        // 11: proto.MergeStandaloneConfigResp
+	(*MergeConfigGroupResp)(nil),             // 12: proto.MergeConfigGroupResp
+	(*PatchOperation)(nil),                   // 13: proto.PatchOperation
+	(*PatchReq)(nil),                         // 14: proto.PatchReq
+	(*PlaceReq)(nil),                         // 15: proto.PlaceReq
+	(*PlacementConstraints)(nil),             // 16: proto.PlacementConstraints
+	(*PlaceResp)(nil),                        // 17: proto.PlaceResp
+	(*ListPlacementTaskReq)(nil),             // 18: proto.ListPlacementTaskReq
+	(*WatchPlacementReq)(nil),                // 19: proto.WatchPlacementReq
+	(*PlacementSummary)(nil),                 // 20: proto.PlacementSummary
+	(*PlacementEvent)(nil),                   // 21: proto.PlacementEvent
+	(*CancelPlacementReq)(nil),               // 22: proto.CancelPlacementReq
+	(*CancelPlacementResp)(nil),              // 23: proto.CancelPlacementResp
+	(*ListPlacementTaskResp)(nil),            // 24: proto.ListPlacementTaskResp
+	(*ListPlacementsByNodeReq)(nil),          // 25: proto.ListPlacementsByNodeReq
+	(*ListPlacementsByNodeResp)(nil),         // 26: proto.ListPlacementsByNodeResp
+	(*ListDriftReq)(nil),                     // 27: proto.ListDriftReq
+	(*ListDriftResp)(nil),                    // 28: proto.ListDriftResp
+	(*GroupParamSetId)(nil),                  // 29: proto.GroupParamSetId
+	(*DiffSide)(nil),                         // 30: proto.DiffSide
+	(*DiffParamSetsReq)(nil),                 // 31: proto.DiffParamSetsReq
+	(*DiffParamSetsResp)(nil),                // 32: proto.DiffParamSetsResp
+	(*ListPlacementStrategiesReq)(nil),       // 33: proto.ListPlacementStrategiesReq
+	(*PlacementStrategyParam)(nil),           // 34: proto.PlacementStrategyParam
+	(*PlacementStrategy)(nil),                // 35: proto.PlacementStrategy
+	(*ListPlacementStrategiesResp)(nil),      // 36: proto.ListPlacementStrategiesResp
+	(*RolloutId)(nil),                        // 37: proto.RolloutId
+	(*PromoteRolloutReq)(nil),                // 38: proto.PromoteRolloutReq
+	(*ListRolloutsReq)(nil),                  // 39: proto.ListRolloutsReq
+	(*ListRolloutsResp)(nil),                 // 40: proto.ListRolloutsResp
+	(*RollbackResp)(nil),                     // 41: proto.RollbackResp
+	(*MaintenanceWindowId)(nil),              // 42: proto.MaintenanceWindowId
+	(*ListMaintenanceWindowsReq)(nil),        // 43: proto.ListMaintenanceWindowsReq
+	(*ListMaintenanceWindowsResp)(nil),       // 44: proto.ListMaintenanceWindowsResp
+	(*SchedulePlacementReq)(nil),             // 45: proto.SchedulePlacementReq
+	(*ScheduledPlacementId)(nil),             // 46: proto.ScheduledPlacementId
+	(*ListScheduledPlacementsReq)(nil),       // 47: proto.ListScheduledPlacementsReq
+	(*ListScheduledPlacementsResp)(nil),      // 48: proto.ListScheduledPlacementsResp
+	(*PersistentPlacement)(nil),              // 49: proto.PersistentPlacement
+	(*PersistentPlacementId)(nil),            // 50: proto.PersistentPlacementId
+	(*ListPersistentPlacementsReq)(nil),      // 51: proto.ListPersistentPlacementsReq
+	(*ListPersistentPlacementsResp)(nil),     // 52: proto.ListPersistentPlacementsResp
+	nil,                                      // 53: proto.SearchConfigReq.LabelsEntry
+	nil,                                      // 54: proto.DiffConfigGroupResp.DiffsEntry
+	nil,                                      // 55: proto.DiffConfigGroupResp.StatsEntry
+	(*PlaceReq_Strategy)(nil),                // 56: proto.PlaceReq.Strategy
+	(*PlaceReq_Rollout)(nil),                 // 57: proto.PlaceReq.Rollout
+	(*PlaceReq_Rolling)(nil),                 // 58: proto.PlaceReq.Rolling
+	nil,                                      // 59: proto.PlaceReq.Strategy.ParamsEntry
+	(*PlacementConstraints_MaxPerLabel)(nil), // 60: proto.PlacementConstraints.MaxPerLabel
+	(*PlacementConstraints_ConfigRef)(nil),   // 61: proto.PlacementConstraints.ConfigRef
+	nil,                                      // 62: proto.PlacementSummary.StatusesEntry
+	(*StandaloneConfig)(nil),                 // 63: proto.StandaloneConfig
+	(*ConfigId)(nil),                         // 64: proto.ConfigId
+	(*Diff)(nil),                             // 65: proto.Diff
+	(*ConfigGroup)(nil),                      // 66: proto.ConfigGroup
+	(*DiffStats)(nil),                        // 67: proto.DiffStats
+	(*Schema)(nil),                           // 68: proto.Schema
+	(*MergeConflict)(nil),                    // 69: proto.MergeConflict
+	(*PlacementTask)(nil),                    // 70: proto.PlacementTask
+	(*PlacementPlan)(nil),                    // 71: proto.PlacementPlan
+	(*ConstraintViolation)(nil),              // 72: proto.ConstraintViolation
+	(*NodePlacement)(nil),                    // 73: proto.NodePlacement
+	(*ConfigDrift)(nil),                      // 74: proto.ConfigDrift
+	(*NamedParamSet)(nil),                    // 75: proto.NamedParamSet
+	(*Rollout)(nil),                          // 76: proto.Rollout
+	(*RollbackPlacement)(nil),                // 77: proto.RollbackPlacement
+	(*MaintenanceWindow)(nil),                // 78: proto.MaintenanceWindow
+	(*ScheduledPlacement)(nil),               // 79: proto.ScheduledPlacement
+	(*Diffs)(nil),                            // 80: proto.Diffs
+	(*api.Selector)(nil),                     // 81: proto.Selector
+	(*NewStandaloneConfig)(nil),              // 82: proto.NewStandaloneConfig
+	(*NewConfigGroup)(nil),                   // 83: proto.NewConfigGroup
+}
+var file_kuiper_proto_depIdxs = []int32{
+	63,  // 0: proto.ListStandaloneConfigResp.configurations:type_name -> proto.StandaloneConfig
+	53,  // 1: proto.SearchConfigReq.labels:type_name -> proto.SearchConfigReq.LabelsEntry
+	64,  // 2: proto.DiffReq.reference:type_name -> proto.ConfigId
+	64,  // 3: proto.DiffReq.diff:type_name -> proto.ConfigId
+	0,   // 4: proto.DiffReq.format:type_name -> proto.DiffFormat
+	65,  // 5: proto.DiffStandaloneConfigResp.diffs:type_name -> proto.Diff
+	66,  // 6: proto.ListConfigGroupResp.groups:type_name -> proto.ConfigGroup
+	54,  // 7: proto.DiffConfigGroupResp.diffs:type_name -> proto.DiffConfigGroupResp.DiffsEntry
+	55,  // 8: proto.DiffConfigGroupResp.stats:type_name -> proto.DiffConfigGroupResp.StatsEntry
+	67,  // 9: proto.DiffConfigGroupResp.total:type_name -> proto.DiffStats
+	64,  // 10: proto.MergeReq.base:type_name -> proto.ConfigId
+	64,  // 11: proto.MergeReq.ours:type_name -> proto.ConfigId
+	64,  // 12: proto.MergeReq.theirs:type_name -> proto.ConfigId
+	68,  // 13: proto.MergeReq.schema:type_name -> proto.Schema
+	63,  // 14: proto.MergeStandaloneConfigResp.merged:type_name -> proto.StandaloneConfig
+	69,  // 15: proto.MergeStandaloneConfigResp.conflicts:type_name -> proto.MergeConflict
+	66,  // 16: proto.MergeConfigGroupResp.merged:type_name -> proto.ConfigGroup
+	69,  // 17: proto.MergeConfigGroupResp.conflicts:type_name -> proto.MergeConflict
+	64,  // 18: proto.PatchReq.source:type_name -> proto.ConfigId
+	13,  // 19: proto.PatchReq.operations:type_name -> proto.PatchOperation
+	68,  // 20: proto.PatchReq.schema:type_name -> proto.Schema
+	64,  // 21: proto.PlaceReq.config:type_name -> proto.ConfigId
+	56,  // 22: proto.PlaceReq.strategy:type_name -> proto.PlaceReq.Strategy
+	57,  // 23: proto.PlaceReq.rollout:type_name -> proto.PlaceReq.Rollout
+	58,  // 24: proto.PlaceReq.rolling:type_name -> proto.PlaceReq.Rolling
+	60,  // 25: proto.PlacementConstraints.maxPerLabel:type_name -> proto.PlacementConstraints.MaxPerLabel
+	61,  // 26: proto.PlacementConstraints.antiAffinity:type_name -> proto.PlacementConstraints.ConfigRef
+	70,  // 27: proto.PlaceResp.tasks:type_name -> proto.PlacementTask
+	71,  // 28: proto.PlaceResp.plan:type_name -> proto.PlacementPlan
+	72,  // 29: proto.PlaceResp.violations:type_name -> proto.ConstraintViolation
+	62,  // 30: proto.PlacementSummary.statuses:type_name -> proto.PlacementSummary.StatusesEntry
+	70,  // 31: proto.PlacementEvent.task:type_name -> proto.PlacementTask
+	20,  // 32: proto.PlacementEvent.summary:type_name -> proto.PlacementSummary
+	70,  // 33: proto.CancelPlacementResp.cancelled:type_name -> proto.PlacementTask
+	70,  // 34: proto.ListPlacementTaskResp.tasks:type_name -> proto.PlacementTask
+	73,  // 35: proto.ListPlacementsByNodeResp.placements:type_name -> proto.NodePlacement
+	74,  // 36: proto.ListDriftResp.drifts:type_name -> proto.ConfigDrift
+	64,  // 37: proto.GroupParamSetId.group:type_name -> proto.ConfigId
+	64,  // 38: proto.DiffSide.standalone:type_name -> proto.ConfigId
+	29,  // 39: proto.DiffSide.group:type_name -> proto.GroupParamSetId
+	75,  // 40: proto.DiffSide.inline:type_name -> proto.NamedParamSet
+	30,  // 41: proto.DiffParamSetsReq.reference:type_name -> proto.DiffSide
+	30,  // 42: proto.DiffParamSetsReq.diff:type_name -> proto.DiffSide
+	0,   // 43: proto.DiffParamSetsReq.format:type_name -> proto.DiffFormat
+	65,  // 44: proto.DiffParamSetsResp.diffs:type_name -> proto.Diff
+	67,  // 45: proto.DiffParamSetsResp.stats:type_name -> proto.DiffStats
+	34,  // 46: proto.PlacementStrategy.params:type_name -> proto.PlacementStrategyParam
+	35,  // 47: proto.ListPlacementStrategiesResp.strategies:type_name -> proto.PlacementStrategy
+	76,  // 48: proto.ListRolloutsResp.rollouts:type_name -> proto.Rollout
+	77,  // 49: proto.RollbackResp.rollbacks:type_name -> proto.RollbackPlacement
+	78,  // 50: proto.ListMaintenanceWindowsResp.windows:type_name -> proto.MaintenanceWindow
+	15,  // 51: proto.SchedulePlacementReq.placement:type_name -> proto.PlaceReq
+	79,  // 52: proto.ListScheduledPlacementsResp.placements:type_name -> proto.ScheduledPlacement
+	64,  // 53: proto.PersistentPlacement.config:type_name -> proto.ConfigId
+	56,  // 54: proto.PersistentPlacement.strategy:type_name -> proto.PlaceReq.Strategy
+	49,  // 55: proto.ListPersistentPlacementsResp.placements:type_name -> proto.PersistentPlacement
+	80,  // 56: proto.DiffConfigGroupResp.DiffsEntry.value:type_name -> proto.Diffs
+	67,  // 57: proto.DiffConfigGroupResp.StatsEntry.value:type_name -> proto.DiffStats
+	81,  // 58: proto.PlaceReq.Strategy.query:type_name -> proto.Selector
+	59,  // 59: proto.PlaceReq.Strategy.params:type_name -> proto.PlaceReq.Strategy.ParamsEntry
+	16,  // 60: proto.PlaceReq.Strategy.constraints:type_name -> proto.PlacementConstraints
+	82,  // 61: proto.Kuiper.PutStandaloneConfig:input_type -> proto.NewStandaloneConfig
+	64,  // 62: proto.Kuiper.GetStandaloneConfig:input_type -> proto.ConfigId
+	1,   // 63: proto.Kuiper.ListStandaloneConfig:input_type -> proto.ListStandaloneConfigReq
+	3,   // 64: proto.Kuiper.ListOrgStandaloneConfig:input_type -> proto.ListOrgConfigReq
+	4,   // 65: proto.Kuiper.SearchStandaloneConfig:input_type -> proto.SearchConfigReq
+	64,  // 66: proto.Kuiper.DeleteStandaloneConfig:input_type -> proto.ConfigId
+	15,  // 67: proto.Kuiper.PlaceStandaloneConfig:input_type -> proto.PlaceReq
+	18,  // 68: proto.Kuiper.ListPlacementTaskByStandaloneConfig:input_type -> proto.ListPlacementTaskReq
+	19,  // 69: proto.Kuiper.WatchStandaloneConfigPlacement:input_type -> proto.WatchPlacementReq
+	22,  // 70: proto.Kuiper.CancelStandaloneConfigPlacement:input_type -> proto.CancelPlacementReq
+	64,  // 71: proto.Kuiper.RollbackStandaloneConfig:input_type -> proto.ConfigId
+	5,   // 72: proto.Kuiper.DiffStandaloneConfig:input_type -> proto.DiffReq
+	10,  // 73: proto.Kuiper.MergeStandaloneConfig:input_type -> proto.MergeReq
+	14,  // 74: proto.Kuiper.PatchStandaloneConfig:input_type -> proto.PatchReq
+	83,  // 75: proto.Kuiper.PutConfigGroup:input_type -> proto.NewConfigGroup
+	64,  // 76: proto.Kuiper.GetConfigGroup:input_type -> proto.ConfigId
+	7,   // 77: proto.Kuiper.ListConfigGroup:input_type -> proto.ListConfigGroupReq
+	3,   // 78: proto.Kuiper.ListOrgConfigGroup:input_type -> proto.ListOrgConfigReq
+	4,   // 79: proto.Kuiper.SearchConfigGroup:input_type -> proto.SearchConfigReq
+	64,  // 80: proto.Kuiper.DeleteConfigGroup:input_type -> proto.ConfigId
+	15,  // 81: proto.Kuiper.PlaceConfigGroup:input_type -> proto.PlaceReq
+	18,  // 82: proto.Kuiper.ListPlacementTaskByConfigGroup:input_type -> proto.ListPlacementTaskReq
+	19,  // 83: proto.Kuiper.WatchConfigGroupPlacement:input_type -> proto.WatchPlacementReq
+	22,  // 84: proto.Kuiper.CancelConfigGroupPlacement:input_type -> proto.CancelPlacementReq
+	64,  // 85: proto.Kuiper.RollbackConfigGroup:input_type -> proto.ConfigId
+	5,   // 86: proto.Kuiper.DiffConfigGroup:input_type -> proto.DiffReq
+	10,  // 87: proto.Kuiper.MergeConfigGroup:input_type -> proto.MergeReq
+	14,  // 88: proto.Kuiper.PatchConfigGroup:input_type -> proto.PatchReq
+	25,  // 89: proto.Kuiper.ListPlacementsByNode:input_type -> proto.ListPlacementsByNodeReq
+	27,  // 90: proto.Kuiper.ListDrift:input_type -> proto.ListDriftReq
+	31,  // 91: proto.Kuiper.DiffParamSets:input_type -> proto.DiffParamSetsReq
+	33,  // 92: proto.Kuiper.ListPlacementStrategies:input_type -> proto.ListPlacementStrategiesReq
+	37,  // 93: proto.Kuiper.GetRollout:input_type -> proto.RolloutId
+	39,  // 94: proto.Kuiper.ListRollouts:input_type -> proto.ListRolloutsReq
+	37,  // 95: proto.Kuiper.PauseRollout:input_type -> proto.RolloutId
+	37,  // 96: proto.Kuiper.ResumeRollout:input_type -> proto.RolloutId
+	38,  // 97: proto.Kuiper.PromoteRollout:input_type -> proto.PromoteRolloutReq
+	37,  // 98: proto.Kuiper.AbortRollout:input_type -> proto.RolloutId
+	78,  // 99: proto.Kuiper.PutMaintenanceWindow:input_type -> proto.MaintenanceWindow
+	42,  // 100: proto.Kuiper.DeleteMaintenanceWindow:input_type -> proto.MaintenanceWindowId
+	43,  // 101: proto.Kuiper.ListMaintenanceWindows:input_type -> proto.ListMaintenanceWindowsReq
+	45,  // 102: proto.Kuiper.ScheduleStandaloneConfigPlacement:input_type -> proto.SchedulePlacementReq
+	45,  // 103: proto.Kuiper.ScheduleConfigGroupPlacement:input_type -> proto.SchedulePlacementReq
+	47,  // 104: proto.Kuiper.ListScheduledPlacements:input_type -> proto.ListScheduledPlacementsReq
+	46,  // 105: proto.Kuiper.CancelScheduledPlacement:input_type -> proto.ScheduledPlacementId
+	51,  // 106: proto.Kuiper.ListPersistentPlacements:input_type -> proto.ListPersistentPlacementsReq
+	50,  // 107: proto.Kuiper.DeletePersistentPlacement:input_type -> proto.PersistentPlacementId
+	63,  // 108: proto.Kuiper.PutStandaloneConfig:output_type -> proto.StandaloneConfig
+	63,  // 109: proto.Kuiper.GetStandaloneConfig:output_type -> proto.StandaloneConfig
+	2,   // 110: proto.Kuiper.ListStandaloneConfig:output_type -> proto.ListStandaloneConfigResp
+	2,   // 111: proto.Kuiper.ListOrgStandaloneConfig:output_type -> proto.ListStandaloneConfigResp
+	2,   // 112: proto.Kuiper.SearchStandaloneConfig:output_type -> proto.ListStandaloneConfigResp
+	63,  // 113: proto.Kuiper.DeleteStandaloneConfig:output_type -> proto.StandaloneConfig
+	17,  // 114: proto.Kuiper.PlaceStandaloneConfig:output_type -> proto.PlaceResp
+	24,  // 115: proto.Kuiper.ListPlacementTaskByStandaloneConfig:output_type -> proto.ListPlacementTaskResp
+	21,  // 116: proto.Kuiper.WatchStandaloneConfigPlacement:output_type -> proto.PlacementEvent
+	23,  // 117: proto.Kuiper.CancelStandaloneConfigPlacement:output_type -> proto.CancelPlacementResp
+	41,  // 118: proto.Kuiper.RollbackStandaloneConfig:output_type -> proto.RollbackResp
+	6,   // 119: proto.Kuiper.DiffStandaloneConfig:output_type -> proto.DiffStandaloneConfigResp
+	11,  // 120: proto.Kuiper.MergeStandaloneConfig:output_type -> proto.MergeStandaloneConfigResp
+	63,  // 121: proto.Kuiper.PatchStandaloneConfig:output_type -> proto.StandaloneConfig
+	66,  // 122: proto.Kuiper.PutConfigGroup:output_type -> proto.ConfigGroup
+	66,  // 123: proto.Kuiper.GetConfigGroup:output_type -> proto.ConfigGroup
+	8,   // 124: proto.Kuiper.ListConfigGroup:output_type -> proto.ListConfigGroupResp
+	8,   // 125: proto.Kuiper.ListOrgConfigGroup:output_type -> proto.ListConfigGroupResp
+	8,   // 126: proto.Kuiper.SearchConfigGroup:output_type -> proto.ListConfigGroupResp
+	66,  // 127: proto.Kuiper.DeleteConfigGroup:output_type -> proto.ConfigGroup
+	17,  // 128: proto.Kuiper.PlaceConfigGroup:output_type -> proto.PlaceResp
+	24,  // 129: proto.Kuiper.ListPlacementTaskByConfigGroup:output_type -> proto.ListPlacementTaskResp
+	21,  // 130: proto.Kuiper.WatchConfigGroupPlacement:output_type -> proto.PlacementEvent
+	23,  // 131: proto.Kuiper.CancelConfigGroupPlacement:output_type -> proto.CancelPlacementResp
+	41,  // 132: proto.Kuiper.RollbackConfigGroup:output_type -> proto.RollbackResp
+	9,   // 133: proto.Kuiper.DiffConfigGroup:output_type -> proto.DiffConfigGroupResp
+	12,  // 134: proto.Kuiper.MergeConfigGroup:output_type -> proto.MergeConfigGroupResp
+	66,  // 135: proto.Kuiper.PatchConfigGroup:output_type -> proto.ConfigGroup
+	26,  // 136: proto.Kuiper.ListPlacementsByNode:output_type -> proto.ListPlacementsByNodeResp
+	28,  // 137: proto.Kuiper.ListDrift:output_type -> proto.ListDriftResp
+	32,  // 138: proto.Kuiper.DiffParamSets:output_type -> proto.DiffParamSetsResp
+	36,  // 139: proto.Kuiper.ListPlacementStrategies:output_type -> proto.ListPlacementStrategiesResp
+	76,  // 140: proto.Kuiper.GetRollout:output_type -> proto.Rollout
+	40,  // 141: proto.Kuiper.ListRollouts:output_type -> proto.ListRolloutsResp
+	76,  // 142: proto.Kuiper.PauseRollout:output_type -> proto.Rollout
+	76,  // 143: proto.Kuiper.ResumeRollout:output_type -> proto.Rollout
+	76,  // 144: proto.Kuiper.PromoteRollout:output_type -> proto.Rollout
+	76,  // 145: proto.Kuiper.AbortRollout:output_type -> proto.Rollout
+	78,  // 146: proto.Kuiper.PutMaintenanceWindow:output_type -> proto.MaintenanceWindow
+	78,  // 147: proto.Kuiper.DeleteMaintenanceWindow:output_type -> proto.MaintenanceWindow
+	44,  // 148: proto.Kuiper.ListMaintenanceWindows:output_type -> proto.ListMaintenanceWindowsResp
+	79,  // 149: proto.Kuiper.ScheduleStandaloneConfigPlacement:output_type -> proto.ScheduledPlacement
+	79,  // 150: proto.Kuiper.ScheduleConfigGroupPlacement:output_type -> proto.ScheduledPlacement
+	48,  // 151: proto.Kuiper.ListScheduledPlacements:output_type -> proto.ListScheduledPlacementsResp
+	79,  // 152: proto.Kuiper.CancelScheduledPlacement:output_type -> proto.ScheduledPlacement
+	52,  // 153: proto.Kuiper.ListPersistentPlacements:output_type -> proto.ListPersistentPlacementsResp
+	49,  // 154: proto.Kuiper.DeletePersistentPlacement:output_type -> proto.PersistentPlacement
+	108, // [108:155] is the sub-list for method output_type
+	61,  // [61:108] is the sub-list for method input_type
+	61,  // [61:61] is the sub-list for extension type_name
+	61,  // [61:61] is the sub-list for extension extendee
+	0,   // [0:61] is the sub-list for field type_name
+}
+
+func init() { file_kuiper_proto_init() }
+func file_kuiper_proto_init() {
+	if File_kuiper_proto != nil {
+		return
+	}
+	file_kuiper_model_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_kuiper_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStandaloneConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStandaloneConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrgConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffStandaloneConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConfigGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConfigGroupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffConfigGroupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeStandaloneConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeConfigGroupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlacementConstraints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlacementTaskReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchPlacementReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlacementSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlacementEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPlacementReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPlacementResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlacementTaskResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlacementsByNodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlacementsByNodeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDriftReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDriftResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupParamSetId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffSide); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffParamSetsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffParamSetsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlacementStrategiesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlacementStrategyParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlacementStrategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlacementStrategiesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteRolloutReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRolloutsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRolloutsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListPlacementTaskResp.ProtoReflect.Descriptor instead.
-func (*ListPlacementTaskResp) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *ListPlacementTaskResp) GetTasks() []*PlacementTask {
-	if x != nil {
-		return x.Tasks
-	}
-	return nil
-}
-
-type PlaceReq_Strategy struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name       string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Query      []*api.Selector `protobuf:"bytes,2,rep,name=query,proto3" json:"query,omitempty"`
-	Percentage int32           `protobuf:"varint,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
-}
-
-func (x *PlaceReq_Strategy) Reset() {
-	*x = PlaceReq_Strategy{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *PlaceReq_Strategy) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PlaceReq_Strategy) ProtoMessage() {}
-
-func (x *PlaceReq_Strategy) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_kuiper_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceWindowId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PlaceReq_Strategy.ProtoReflect.Descriptor instead.
-func (*PlaceReq_Strategy) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{7, 0}
-}
-
-func (x *PlaceReq_Strategy) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *PlaceReq_Strategy) GetQuery() []*api.Selector {
-	if x != nil {
-		return x.Query
-	}
-	return nil
-}
-
-func (x *PlaceReq_Strategy) GetPercentage() int32 {
-	if x != nil {
-		return x.Percentage
-	}
-	return 0
-}
-
-var File_kuiper_proto protoreflect.FileDescriptor
-
-var file_kuiper_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x6b, 0x75, 0x69, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x6b, 0x75, 0x69, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x6d, 0x61, 0x67, 0x6e, 0x65,
-	0x74, 0x61, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5b, 0x0a, 0x17, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x5b, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
-	0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x22, 0x5d, 0x0a, 0x07, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x12, 0x2d,
-	0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x49, 0x64, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a,
-	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x04, 0x64, 0x69,
-	0x66, 0x66, 0x22, 0x3d, 0x0a, 0x18, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61,
-	0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21,
-	0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66,
-	0x73, 0x22, 0x56, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x41, 0x0a, 0x13, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x2a, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x9a, 0x01, 0x0a,
-	0x13, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x3b, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e,
-	0x44, 0x69, 0x66, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66,
-	0x73, 0x1a, 0x46, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x73, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x01, 0x0a, 0x08, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x34, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x1a, 0x65, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x37, 0x0a, 0x09,
-	0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x05, 0x74, 0x61, 0x73,
-	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05,
-	0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x43, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a,
-	0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x32, 0xe8, 0x07, 0x0a, 0x06, 0x4b,
-	0x75, 0x69, 0x70, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x13, 0x50, 0x75, 0x74, 0x53, 0x74, 0x61, 0x6e,
-	0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f,
-	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61,
-	0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x17, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
-	0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64,
-	0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64,
-	0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x44, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64,
-	0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x17, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x53, 0x74, 0x61, 0x6e,
-	0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x1c, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x49, 0x0a,
-	0x14, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
-	0x66, 0x66, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
-	0x66, 0x66, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00,
-	0x12, 0x4a, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x1a,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x49, 0x64, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x51, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x49, 0x64, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_kuiper_proto_rawDescOnce sync.Once
-	file_kuiper_proto_rawDescData = file_kuiper_proto_rawDesc
-)
-
-func file_kuiper_proto_rawDescGZIP() []byte {
-	file_kuiper_proto_rawDescOnce.Do(func() {
-		file_kuiper_proto_rawDescData = protoimpl.X.CompressGZIP(file_kuiper_proto_rawDescData)
-	})
-	return file_kuiper_proto_rawDescData
-}
-
-var file_kuiper_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
-var file_kuiper_proto_goTypes = []interface{}{
-	(*ListStandaloneConfigReq)(nil),  // 0: proto.ListStandaloneConfigReq
-	(*ListStandaloneConfigResp)(nil), // 1: proto.ListStandaloneConfigResp
-	(*DiffReq)(nil),                  // 2: proto.DiffReq
-	(*DiffStandaloneConfigResp)(nil), // 3: proto.DiffStandaloneConfigResp
-	(*ListConfigGroupReq)(nil),       // 4: proto.ListConfigGroupReq
-	(*ListConfigGroupResp)(nil),      // 5: proto.ListConfigGroupResp
-	(*DiffConfigGroupResp)(nil),      // 6: proto.DiffConfigGroupResp
-	(*PlaceReq)(nil),                 // 7: proto.PlaceReq
-	(*PlaceResp)(nil),                // 8: proto.PlaceResp
-	(*ListPlacementTaskResp)(nil),    // 9: proto.ListPlacementTaskResp
-	nil,                              // 10: proto.DiffConfigGroupResp.DiffsEntry
-	(*PlaceReq_Strategy)(nil),        // 11: proto.PlaceReq.Strategy
-	(*StandaloneConfig)(nil),         // 12: proto.StandaloneConfig
-	(*ConfigId)(nil),                 // 13: proto.ConfigId
-	(*Diff)(nil),                     // 14: proto.Diff
-	(*ConfigGroup)(nil),              // 15: proto.ConfigGroup
-	(*PlacementTask)(nil),            // 16: proto.PlacementTask
-	(*Diffs)(nil),                    // 17: proto.Diffs
-	(*api.Selector)(nil),             // 18: proto.Selector
-	(*NewStandaloneConfig)(nil),      // 19: proto.NewStandaloneConfig
-	(*NewConfigGroup)(nil),           // 20: proto.NewConfigGroup
-}
-var file_kuiper_proto_depIdxs = []int32{
-	12, // 0: proto.ListStandaloneConfigResp.configurations:type_name -> proto.StandaloneConfig
-	13, // 1: proto.DiffReq.reference:type_name -> proto.ConfigId
-	13, // 2: proto.DiffReq.diff:type_name -> proto.ConfigId
-	14, // 3: proto.DiffStandaloneConfigResp.diffs:type_name -> proto.Diff
-	15, // 4: proto.ListConfigGroupResp.groups:type_name -> proto.ConfigGroup
-	10, // 5: proto.DiffConfigGroupResp.diffs:type_name -> proto.DiffConfigGroupResp.DiffsEntry
-	13, // 6: proto.PlaceReq.config:type_name -> proto.ConfigId
-	11, // 7: proto.PlaceReq.strategy:type_name -> proto.PlaceReq.Strategy
-	16, // 8: proto.PlaceResp.tasks:type_name -> proto.PlacementTask
-	16, // 9: proto.ListPlacementTaskResp.tasks:type_name -> proto.PlacementTask
-	17, // 10: proto.DiffConfigGroupResp.DiffsEntry.value:type_name -> proto.Diffs
-	18, // 11: proto.PlaceReq.Strategy.query:type_name -> proto.Selector
-	19, // 12: proto.Kuiper.PutStandaloneConfig:input_type -> proto.NewStandaloneConfig
-	13, // 13: proto.Kuiper.GetStandaloneConfig:input_type -> proto.ConfigId
-	0,  // 14: proto.Kuiper.ListStandaloneConfig:input_type -> proto.ListStandaloneConfigReq
-	13, // 15: proto.Kuiper.DeleteStandaloneConfig:input_type -> proto.ConfigId
-	7,  // 16: proto.Kuiper.PlaceStandaloneConfig:input_type -> proto.PlaceReq
-	13, // 17: proto.Kuiper.ListPlacementTaskByStandaloneConfig:input_type -> proto.ConfigId
-	2,  // 18: proto.Kuiper.DiffStandaloneConfig:input_type -> proto.DiffReq
-	20, // 19: proto.Kuiper.PutConfigGroup:input_type -> proto.NewConfigGroup
-	13, // 20: proto.Kuiper.GetConfigGroup:input_type -> proto.ConfigId
-	4,  // 21: proto.Kuiper.ListConfigGroup:input_type -> proto.ListConfigGroupReq
-	13, // 22: proto.Kuiper.DeleteConfigGroup:input_type -> proto.ConfigId
-	7,  // 23: proto.Kuiper.PlaceConfigGroup:input_type -> proto.PlaceReq
-	13, // 24: proto.Kuiper.ListPlacementTaskByConfigGroup:input_type -> proto.ConfigId
-	2,  // 25: proto.Kuiper.DiffConfigGroup:input_type -> proto.DiffReq
-	12, // 26: proto.Kuiper.PutStandaloneConfig:output_type -> proto.StandaloneConfig
-	12, // 27: proto.Kuiper.GetStandaloneConfig:output_type -> proto.StandaloneConfig
-	1,  // 28: proto.Kuiper.ListStandaloneConfig:output_type -> proto.ListStandaloneConfigResp
-	12, // 29: proto.Kuiper.DeleteStandaloneConfig:output_type -> proto.StandaloneConfig
-	8,  // 30: proto.Kuiper.PlaceStandaloneConfig:output_type -> proto.PlaceResp
-	9,  // 31: proto.Kuiper.ListPlacementTaskByStandaloneConfig:output_type -> proto.ListPlacementTaskResp
-	3,  // 32: proto.Kuiper.DiffStandaloneConfig:output_type -> proto.DiffStandaloneConfigResp
-	15, // 33: proto.Kuiper.PutConfigGroup:output_type -> proto.ConfigGroup
-	15, // 34: proto.Kuiper.GetConfigGroup:output_type -> proto.ConfigGroup
-	5,  // 35: proto.Kuiper.ListConfigGroup:output_type -> proto.ListConfigGroupResp
-	15, // 36: proto.Kuiper.DeleteConfigGroup:output_type -> proto.ConfigGroup
-	8,  // 37: proto.Kuiper.PlaceConfigGroup:output_type -> proto.PlaceResp
-	9,  // 38: proto.Kuiper.ListPlacementTaskByConfigGroup:output_type -> proto.ListPlacementTaskResp
-	6,  // 39: proto.Kuiper.DiffConfigGroup:output_type -> proto.DiffConfigGroupResp
-	26, // [26:40] is the sub-list for method output_type
-	12, // [12:26] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
-}
-
-func init() { file_kuiper_proto_init() }
-func file_kuiper_proto_init() {
-	if File_kuiper_proto != nil {
-		return
-	}
-	file_kuiper_model_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_kuiper_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStandaloneConfigReq); i {
+		file_kuiper_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMaintenanceWindowsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -823,8 +5413,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStandaloneConfigResp); i {
+		file_kuiper_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMaintenanceWindowsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -835,8 +5425,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiffReq); i {
+		file_kuiper_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulePlacementReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -847,8 +5437,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiffStandaloneConfigResp); i {
+		file_kuiper_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledPlacementId); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -859,8 +5449,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListConfigGroupReq); i {
+		file_kuiper_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledPlacementsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -871,8 +5461,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListConfigGroupResp); i {
+		file_kuiper_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledPlacementsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -883,8 +5473,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiffConfigGroupResp); i {
+		file_kuiper_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistentPlacement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -895,8 +5485,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaceReq); i {
+		file_kuiper_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistentPlacementId); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -907,8 +5497,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaceResp); i {
+		file_kuiper_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPersistentPlacementsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -919,8 +5509,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPlacementTaskResp); i {
+		file_kuiper_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPersistentPlacementsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -931,7 +5521,7 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_kuiper_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PlaceReq_Strategy); i {
 			case 0:
 				return &v.state
@@ -943,19 +5533,81 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
+		file_kuiper_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceReq_Rollout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceReq_Rolling); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlacementConstraints_MaxPerLabel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlacementConstraints_ConfigRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_kuiper_proto_msgTypes[20].OneofWrappers = []interface{}{
+		(*PlacementEvent_Task)(nil),
+		(*PlacementEvent_Summary)(nil),
+	}
+	file_kuiper_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*DiffSide_Standalone)(nil),
+		(*DiffSide_Group)(nil),
+		(*DiffSide_Inline)(nil),
+	}
+	file_kuiper_proto_msgTypes[57].OneofWrappers = []interface{}{
+		(*PlaceReq_Rolling_BatchSize)(nil),
+		(*PlaceReq_Rolling_BatchPercentage)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kuiper_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      1,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_kuiper_proto_goTypes,
 		DependencyIndexes: file_kuiper_proto_depIdxs,
+		EnumInfos:         file_kuiper_proto_enumTypes,
 		MessageInfos:      file_kuiper_proto_msgTypes,
 	}.Build()
 	File_kuiper_proto = out.File
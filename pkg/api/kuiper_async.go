@@ -1,8 +1,11 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"sync"
+	"time"
 
 	"github.com/c12s/magnetar/pkg/messaging"
 	"github.com/c12s/magnetar/pkg/messaging/nats"
@@ -10,9 +13,25 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+const (
+	AppliedConfigsSubject = "kuiper.applied_configs"
+	// PlacementTimeoutsSubject receives a PlacementTaskTimedOut for every task
+	// that timed out.
+	PlacementTimeoutsSubject = "kuiper.placements.timed_out"
+)
+
+// number of cancelled task ids the client remembers
+const maxCancelledTasks = 1024
+
 type KuiperAsyncClient struct {
-	subscriber messaging.Subscriber
-	publisher  messaging.Publisher
+	nodeId        string
+	subscriber    messaging.Subscriber
+	publisher     messaging.Publisher
+	removeHandler RemoveConfigHandler
+	mu            sync.Mutex
+	cancelled     map[string]bool
+	// cancelled task ids, oldest first
+	cancelledIds []string
 }
 
 func NewKuiperAsyncClient(address, nodeId string) (*KuiperAsyncClient, error) {
@@ -29,12 +48,28 @@ func NewKuiperAsyncClient(address, nodeId string) (*KuiperAsyncClient, error) {
 		return nil, err
 	}
 	return &KuiperAsyncClient{
+		nodeId:     nodeId,
 		subscriber: subscriber,
 		publisher:  publisher,
+		cancelled:  make(map[string]bool),
 	}, nil
 }
 
 func (c *KuiperAsyncClient) ReceiveConfig(standaloneHandler PutStandaloneConfigHandler, groupHandler PutConfigGroupHandler) error {
+	return c.ReceiveConfigWithProgress(
+		func(config *StandaloneConfig, namespace, strategy string, _ Progress) error {
+			return standaloneHandler(config, namespace, strategy)
+		},
+		func(config *ConfigGroup, namespace, strategy string, _ Progress) error {
+			return groupHandler(config, namespace, strategy)
+		},
+	)
+}
+
+// ReceiveConfigWithProgress is like ReceiveConfig, but the handlers can report
+// progress while applying a config. A handler that returns an error created by
+// Reject marks the task as rejected instead of failed.
+func (c *KuiperAsyncClient) ReceiveConfigWithProgress(standaloneHandler ApplyStandaloneConfigHandler, groupHandler ApplyConfigGroupHandler) error {
 	err := c.subscriber.Subscribe(func(msg []byte, replySubject string) {
 		cmd := &ApplyConfigCommand{}
 		err := proto.Unmarshal(msg, cmd)
@@ -42,66 +77,180 @@ func (c *KuiperAsyncClient) ReceiveConfig(standaloneHandler PutStandaloneConfigH
 			log.Println(err)
 			return
 		}
+		if cmd.Cancel {
+			c.cancel(cmd.TaskId)
+			return
+		}
+		if c.isCancelled(cmd.TaskId) {
+			log.Printf("ignoring apply command of cancelled task %s", cmd.TaskId)
+			return
+		}
+		start := time.Now()
+		progressed := int32(0)
+		progress := func(percent int32, message string) {
+			progressed = percent
+			c.reply(replySubject, &ApplyConfigReply{
+				Cmd:            cmd,
+				Status:         TaskStatus_InProgress,
+				NodeId:         c.nodeId,
+				Message:        message,
+				Progress:       percent,
+				DurationMillis: time.Since(start).Milliseconds(),
+			})
+		}
+		var contentHash string
 		switch cmd.Type {
 		case "standalone":
 			config := &StandaloneConfig{}
-			err := proto.Unmarshal(cmd.Config, config)
-			if err != nil {
+			if err := proto.Unmarshal(cmd.Config, config); err != nil {
 				log.Println(err)
 				return
 			}
-			err = standaloneHandler(config, cmd.Namespace, cmd.Strategy)
-			reply := &ApplyConfigReply{
-				Cmd: cmd,
-			}
-			if err != nil {
-				log.Println(err)
-				reply.Status = TaskStatus_Failed
-			} else {
-				reply.Status = TaskStatus_Placed
-			}
-			msg, err := proto.Marshal(reply)
-			if err != nil {
-				log.Println(err)
+			if cmd.Remove {
+				c.remove(cmd, config.Name, config.Version, replySubject)
 				return
 			}
-			err = c.publisher.Publish(msg, replySubject)
-			if err != nil {
-				log.Println(err)
-			}
+			contentHash = config.ComputeContentHash()
+			err = standaloneHandler(config, cmd.Namespace, cmd.Strategy, progress)
 		case "group":
 			config := &ConfigGroup{}
-			err := proto.Unmarshal(cmd.Config, config)
-			if err != nil {
+			if err := proto.Unmarshal(cmd.Config, config); err != nil {
 				log.Println(err)
 				return
 			}
-			err = groupHandler(config, cmd.Namespace, cmd.Strategy)
-			reply := &ApplyConfigReply{
-				Cmd: cmd,
-			}
-			if err != nil {
-				log.Println(err)
-				reply.Status = TaskStatus_Failed
-			} else {
-				reply.Status = TaskStatus_Placed
-			}
-			msg, err := proto.Marshal(reply)
-			if err != nil {
-				log.Println(err)
+			if cmd.Remove {
+				c.remove(cmd, config.Name, config.Version, replySubject)
 				return
 			}
-			err = c.publisher.Publish(msg, replySubject)
-			if err != nil {
-				log.Println(err)
-			}
+			contentHash = config.ComputeContentHash()
+			err = groupHandler(config, cmd.Namespace, cmd.Strategy, progress)
 		default:
 			log.Printf("unknown cmd type %s", cmd.Type)
+			return
 		}
+		reply := &ApplyConfigReply{
+			Cmd:            cmd,
+			Status:         TaskStatus_Placed,
+			NodeId:         c.nodeId,
+			ContentHash:    contentHash,
+			Progress:       100,
+			DurationMillis: time.Since(start).Milliseconds(),
+		}
+		if err != nil {
+			log.Println(err)
+			reply.Status = TaskStatus_Failed
+			var rejected *RejectedError
+			if errors.As(err, &rejected) {
+				reply.Status = TaskStatus_Rejected
+			}
+			reply.Message = err.Error()
+			reply.Progress = progressed
+		}
+		c.reply(replySubject, reply)
 	})
 	return err
 }
 
+func (c *KuiperAsyncClient) cancel(taskId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cancelled[taskId] {
+		return
+	}
+	if len(c.cancelledIds) == maxCancelledTasks {
+		delete(c.cancelled, c.cancelledIds[0])
+		c.cancelledIds = c.cancelledIds[1:]
+	}
+	c.cancelled[taskId] = true
+	c.cancelledIds = append(c.cancelledIds, taskId)
+}
+
+func (c *KuiperAsyncClient) isCancelled(taskId string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cancelled[taskId]
+}
+
+// OnRemove sets the handler called when Kuiper removes a config from the
+// node, e.g. because the node stopped matching a persistent placement. It has
+// to be set before configs are received, removals are rejected without it.
+func (c *KuiperAsyncClient) OnRemove(handler RemoveConfigHandler) {
+	c.removeHandler = handler
+}
+
+// remove replies to the removal so that Kuiper marks the task as removed,
+// it's sent again if the agent can't remove the config
+func (c *KuiperAsyncClient) remove(cmd *ApplyConfigCommand, name, version, replySubject string) {
+	start := time.Now()
+	reply := &ApplyConfigReply{
+		Cmd:    cmd,
+		Status: TaskStatus_Placed,
+		NodeId: c.nodeId,
+	}
+	if c.removeHandler == nil {
+		log.Printf("ignoring removal of %s/%s, no handler set", cmd.Namespace, name)
+		reply.Status = TaskStatus_Rejected
+		reply.Message = "no removal handler set"
+	} else if err := c.removeHandler(cmd.Type, cmd.Namespace, name, version); err != nil {
+		log.Println(err)
+		reply.Status = TaskStatus_Failed
+		reply.Message = err.Error()
+	}
+	reply.DurationMillis = time.Since(start).Milliseconds()
+	c.reply(replySubject, reply)
+}
+
+func (c *KuiperAsyncClient) reply(replySubject string, reply *ApplyConfigReply) {
+	msg, err := proto.Marshal(reply)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	err = c.publisher.Publish(msg, replySubject)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// ReportAppliedConfigs sends the full list of configs the node currently runs.
+// Configs left out of the report are considered removed from the node.
+func (c *KuiperAsyncClient) ReportAppliedConfigs(org string, configs []*AppliedConfig) error {
+	report := &AppliedConfigReport{
+		Organization: org,
+		NodeId:       c.nodeId,
+		Configs:      configs,
+	}
+	msg, err := proto.Marshal(report)
+	if err != nil {
+		return err
+	}
+	return c.publisher.Publish(msg, AppliedConfigsSubject)
+}
+
+// StartReporting periodically reports the configs returned by applied until
+// the returned stop function is called.
+func (c *KuiperAsyncClient) StartReporting(org string, interval time.Duration, applied func() []*AppliedConfig) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				err := c.ReportAppliedConfigs(org, applied())
+				if err != nil {
+					log.Println(err)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		ticker.Stop()
+		close(done)
+	}
+}
+
 func (c *KuiperAsyncClient) GracefulStop() {
 	err := c.subscriber.Unsubscribe()
 	if err != nil {
@@ -112,6 +261,29 @@ func (c *KuiperAsyncClient) GracefulStop() {
 type PutStandaloneConfigHandler func(config *StandaloneConfig, namespace, strategy string) error
 type PutConfigGroupHandler func(config *ConfigGroup, namespace, strategy string) error
 
+// Progress reports the percentage of a config applied so far, along with what
+// the agent is currently doing.
+type Progress func(percent int32, message string)
+
+type RemoveConfigHandler func(configType, namespace, name, version string) error
+
+type ApplyStandaloneConfigHandler func(config *StandaloneConfig, namespace, strategy string, progress Progress) error
+type ApplyConfigGroupHandler func(config *ConfigGroup, namespace, strategy string, progress Progress) error
+
+// RejectedError is returned by handlers that refuse to apply a config, as
+// opposed to failing while applying it.
+type RejectedError struct {
+	Reason string
+}
+
+func (e *RejectedError) Error() string {
+	return e.Reason
+}
+
+func Reject(reason string) error {
+	return &RejectedError{Reason: reason}
+}
+
 func Subject(nodeId string) string {
 	return fmt.Sprintf("%s.configs", nodeId)
 }
@@ -25,17 +25,50 @@ type KuiperClient interface {
 	PutStandaloneConfig(ctx context.Context, in *NewStandaloneConfig, opts ...grpc.CallOption) (*StandaloneConfig, error)
 	GetStandaloneConfig(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*StandaloneConfig, error)
 	ListStandaloneConfig(ctx context.Context, in *ListStandaloneConfigReq, opts ...grpc.CallOption) (*ListStandaloneConfigResp, error)
+	ListOrgStandaloneConfig(ctx context.Context, in *ListOrgConfigReq, opts ...grpc.CallOption) (*ListStandaloneConfigResp, error)
+	SearchStandaloneConfig(ctx context.Context, in *SearchConfigReq, opts ...grpc.CallOption) (*ListStandaloneConfigResp, error)
 	DeleteStandaloneConfig(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*StandaloneConfig, error)
 	PlaceStandaloneConfig(ctx context.Context, in *PlaceReq, opts ...grpc.CallOption) (*PlaceResp, error)
-	ListPlacementTaskByStandaloneConfig(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*ListPlacementTaskResp, error)
+	ListPlacementTaskByStandaloneConfig(ctx context.Context, in *ListPlacementTaskReq, opts ...grpc.CallOption) (*ListPlacementTaskResp, error)
+	WatchStandaloneConfigPlacement(ctx context.Context, in *WatchPlacementReq, opts ...grpc.CallOption) (Kuiper_WatchStandaloneConfigPlacementClient, error)
+	CancelStandaloneConfigPlacement(ctx context.Context, in *CancelPlacementReq, opts ...grpc.CallOption) (*CancelPlacementResp, error)
+	RollbackStandaloneConfig(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*RollbackResp, error)
 	DiffStandaloneConfig(ctx context.Context, in *DiffReq, opts ...grpc.CallOption) (*DiffStandaloneConfigResp, error)
+	MergeStandaloneConfig(ctx context.Context, in *MergeReq, opts ...grpc.CallOption) (*MergeStandaloneConfigResp, error)
+	PatchStandaloneConfig(ctx context.Context, in *PatchReq, opts ...grpc.CallOption) (*StandaloneConfig, error)
 	PutConfigGroup(ctx context.Context, in *NewConfigGroup, opts ...grpc.CallOption) (*ConfigGroup, error)
 	GetConfigGroup(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*ConfigGroup, error)
 	ListConfigGroup(ctx context.Context, in *ListConfigGroupReq, opts ...grpc.CallOption) (*ListConfigGroupResp, error)
+	ListOrgConfigGroup(ctx context.Context, in *ListOrgConfigReq, opts ...grpc.CallOption) (*ListConfigGroupResp, error)
+	SearchConfigGroup(ctx context.Context, in *SearchConfigReq, opts ...grpc.CallOption) (*ListConfigGroupResp, error)
 	DeleteConfigGroup(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*ConfigGroup, error)
 	PlaceConfigGroup(ctx context.Context, in *PlaceReq, opts ...grpc.CallOption) (*PlaceResp, error)
-	ListPlacementTaskByConfigGroup(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*ListPlacementTaskResp, error)
+	ListPlacementTaskByConfigGroup(ctx context.Context, in *ListPlacementTaskReq, opts ...grpc.CallOption) (*ListPlacementTaskResp, error)
+	WatchConfigGroupPlacement(ctx context.Context, in *WatchPlacementReq, opts ...grpc.CallOption) (Kuiper_WatchConfigGroupPlacementClient, error)
+	CancelConfigGroupPlacement(ctx context.Context, in *CancelPlacementReq, opts ...grpc.CallOption) (*CancelPlacementResp, error)
+	RollbackConfigGroup(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*RollbackResp, error)
 	DiffConfigGroup(ctx context.Context, in *DiffReq, opts ...grpc.CallOption) (*DiffConfigGroupResp, error)
+	MergeConfigGroup(ctx context.Context, in *MergeReq, opts ...grpc.CallOption) (*MergeConfigGroupResp, error)
+	PatchConfigGroup(ctx context.Context, in *PatchReq, opts ...grpc.CallOption) (*ConfigGroup, error)
+	ListPlacementsByNode(ctx context.Context, in *ListPlacementsByNodeReq, opts ...grpc.CallOption) (*ListPlacementsByNodeResp, error)
+	ListDrift(ctx context.Context, in *ListDriftReq, opts ...grpc.CallOption) (*ListDriftResp, error)
+	DiffParamSets(ctx context.Context, in *DiffParamSetsReq, opts ...grpc.CallOption) (*DiffParamSetsResp, error)
+	ListPlacementStrategies(ctx context.Context, in *ListPlacementStrategiesReq, opts ...grpc.CallOption) (*ListPlacementStrategiesResp, error)
+	GetRollout(ctx context.Context, in *RolloutId, opts ...grpc.CallOption) (*Rollout, error)
+	ListRollouts(ctx context.Context, in *ListRolloutsReq, opts ...grpc.CallOption) (*ListRolloutsResp, error)
+	PauseRollout(ctx context.Context, in *RolloutId, opts ...grpc.CallOption) (*Rollout, error)
+	ResumeRollout(ctx context.Context, in *RolloutId, opts ...grpc.CallOption) (*Rollout, error)
+	PromoteRollout(ctx context.Context, in *PromoteRolloutReq, opts ...grpc.CallOption) (*Rollout, error)
+	AbortRollout(ctx context.Context, in *RolloutId, opts ...grpc.CallOption) (*Rollout, error)
+	PutMaintenanceWindow(ctx context.Context, in *MaintenanceWindow, opts ...grpc.CallOption) (*MaintenanceWindow, error)
+	DeleteMaintenanceWindow(ctx context.Context, in *MaintenanceWindowId, opts ...grpc.CallOption) (*MaintenanceWindow, error)
+	ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsReq, opts ...grpc.CallOption) (*ListMaintenanceWindowsResp, error)
+	ScheduleStandaloneConfigPlacement(ctx context.Context, in *SchedulePlacementReq, opts ...grpc.CallOption) (*ScheduledPlacement, error)
+	ScheduleConfigGroupPlacement(ctx context.Context, in *SchedulePlacementReq, opts ...grpc.CallOption) (*ScheduledPlacement, error)
+	ListScheduledPlacements(ctx context.Context, in *ListScheduledPlacementsReq, opts ...grpc.CallOption) (*ListScheduledPlacementsResp, error)
+	CancelScheduledPlacement(ctx context.Context, in *ScheduledPlacementId, opts ...grpc.CallOption) (*ScheduledPlacement, error)
+	ListPersistentPlacements(ctx context.Context, in *ListPersistentPlacementsReq, opts ...grpc.CallOption) (*ListPersistentPlacementsResp, error)
+	DeletePersistentPlacement(ctx context.Context, in *PersistentPlacementId, opts ...grpc.CallOption) (*PersistentPlacement, error)
 }
 
 type kuiperClient struct {
@@ -73,6 +106,24 @@ func (c *kuiperClient) ListStandaloneConfig(ctx context.Context, in *ListStandal
 	return out, nil
 }
 
+func (c *kuiperClient) ListOrgStandaloneConfig(ctx context.Context, in *ListOrgConfigReq, opts ...grpc.CallOption) (*ListStandaloneConfigResp, error) {
+	out := new(ListStandaloneConfigResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListOrgStandaloneConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) SearchStandaloneConfig(ctx context.Context, in *SearchConfigReq, opts ...grpc.CallOption) (*ListStandaloneConfigResp, error) {
+	out := new(ListStandaloneConfigResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/SearchStandaloneConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kuiperClient) DeleteStandaloneConfig(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*StandaloneConfig, error) {
 	out := new(StandaloneConfig)
 	err := c.cc.Invoke(ctx, "/proto.Kuiper/DeleteStandaloneConfig", in, out, opts...)
@@ -91,7 +142,7 @@ func (c *kuiperClient) PlaceStandaloneConfig(ctx context.Context, in *PlaceReq,
 	return out, nil
 }
 
-func (c *kuiperClient) ListPlacementTaskByStandaloneConfig(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*ListPlacementTaskResp, error) {
+func (c *kuiperClient) ListPlacementTaskByStandaloneConfig(ctx context.Context, in *ListPlacementTaskReq, opts ...grpc.CallOption) (*ListPlacementTaskResp, error) {
 	out := new(ListPlacementTaskResp)
 	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListPlacementTaskByStandaloneConfig", in, out, opts...)
 	if err != nil {
@@ -100,6 +151,56 @@ func (c *kuiperClient) ListPlacementTaskByStandaloneConfig(ctx context.Context,
 	return out, nil
 }
 
+func (c *kuiperClient) WatchStandaloneConfigPlacement(ctx context.Context, in *WatchPlacementReq, opts ...grpc.CallOption) (Kuiper_WatchStandaloneConfigPlacementClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Kuiper_ServiceDesc.Streams[0], "/proto.Kuiper/WatchStandaloneConfigPlacement", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kuiperWatchStandaloneConfigPlacementClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Kuiper_WatchStandaloneConfigPlacementClient interface {
+	Recv() (*PlacementEvent, error)
+	grpc.ClientStream
+}
+
+type kuiperWatchStandaloneConfigPlacementClient struct {
+	grpc.ClientStream
+}
+
+func (x *kuiperWatchStandaloneConfigPlacementClient) Recv() (*PlacementEvent, error) {
+	m := new(PlacementEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *kuiperClient) CancelStandaloneConfigPlacement(ctx context.Context, in *CancelPlacementReq, opts ...grpc.CallOption) (*CancelPlacementResp, error) {
+	out := new(CancelPlacementResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/CancelStandaloneConfigPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) RollbackStandaloneConfig(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*RollbackResp, error) {
+	out := new(RollbackResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/RollbackStandaloneConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kuiperClient) DiffStandaloneConfig(ctx context.Context, in *DiffReq, opts ...grpc.CallOption) (*DiffStandaloneConfigResp, error) {
 	out := new(DiffStandaloneConfigResp)
 	err := c.cc.Invoke(ctx, "/proto.Kuiper/DiffStandaloneConfig", in, out, opts...)
@@ -109,6 +210,24 @@ func (c *kuiperClient) DiffStandaloneConfig(ctx context.Context, in *DiffReq, op
 	return out, nil
 }
 
+func (c *kuiperClient) MergeStandaloneConfig(ctx context.Context, in *MergeReq, opts ...grpc.CallOption) (*MergeStandaloneConfigResp, error) {
+	out := new(MergeStandaloneConfigResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/MergeStandaloneConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) PatchStandaloneConfig(ctx context.Context, in *PatchReq, opts ...grpc.CallOption) (*StandaloneConfig, error) {
+	out := new(StandaloneConfig)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/PatchStandaloneConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kuiperClient) PutConfigGroup(ctx context.Context, in *NewConfigGroup, opts ...grpc.CallOption) (*ConfigGroup, error) {
 	out := new(ConfigGroup)
 	err := c.cc.Invoke(ctx, "/proto.Kuiper/PutConfigGroup", in, out, opts...)
@@ -136,370 +255,1359 @@ func (c *kuiperClient) ListConfigGroup(ctx context.Context, in *ListConfigGroupR
 	return out, nil
 }
 
+func (c *kuiperClient) ListOrgConfigGroup(ctx context.Context, in *ListOrgConfigReq, opts ...grpc.CallOption) (*ListConfigGroupResp, error) {
+	out := new(ListConfigGroupResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListOrgConfigGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) SearchConfigGroup(ctx context.Context, in *SearchConfigReq, opts ...grpc.CallOption) (*ListConfigGroupResp, error) {
+	out := new(ListConfigGroupResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/SearchConfigGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kuiperClient) DeleteConfigGroup(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*ConfigGroup, error) {
 	out := new(ConfigGroup)
 	err := c.cc.Invoke(ctx, "/proto.Kuiper/DeleteConfigGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *kuiperClient) PlaceConfigGroup(ctx context.Context, in *PlaceReq, opts ...grpc.CallOption) (*PlaceResp, error) {
+	out := new(PlaceResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/PlaceConfigGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ListPlacementTaskByConfigGroup(ctx context.Context, in *ListPlacementTaskReq, opts ...grpc.CallOption) (*ListPlacementTaskResp, error) {
+	out := new(ListPlacementTaskResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListPlacementTaskByConfigGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) WatchConfigGroupPlacement(ctx context.Context, in *WatchPlacementReq, opts ...grpc.CallOption) (Kuiper_WatchConfigGroupPlacementClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Kuiper_ServiceDesc.Streams[1], "/proto.Kuiper/WatchConfigGroupPlacement", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kuiperWatchConfigGroupPlacementClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Kuiper_WatchConfigGroupPlacementClient interface {
+	Recv() (*PlacementEvent, error)
+	grpc.ClientStream
+}
+
+type kuiperWatchConfigGroupPlacementClient struct {
+	grpc.ClientStream
+}
+
+func (x *kuiperWatchConfigGroupPlacementClient) Recv() (*PlacementEvent, error) {
+	m := new(PlacementEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *kuiperClient) CancelConfigGroupPlacement(ctx context.Context, in *CancelPlacementReq, opts ...grpc.CallOption) (*CancelPlacementResp, error) {
+	out := new(CancelPlacementResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/CancelConfigGroupPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) RollbackConfigGroup(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*RollbackResp, error) {
+	out := new(RollbackResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/RollbackConfigGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) DiffConfigGroup(ctx context.Context, in *DiffReq, opts ...grpc.CallOption) (*DiffConfigGroupResp, error) {
+	out := new(DiffConfigGroupResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/DiffConfigGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) MergeConfigGroup(ctx context.Context, in *MergeReq, opts ...grpc.CallOption) (*MergeConfigGroupResp, error) {
+	out := new(MergeConfigGroupResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/MergeConfigGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) PatchConfigGroup(ctx context.Context, in *PatchReq, opts ...grpc.CallOption) (*ConfigGroup, error) {
+	out := new(ConfigGroup)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/PatchConfigGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ListPlacementsByNode(ctx context.Context, in *ListPlacementsByNodeReq, opts ...grpc.CallOption) (*ListPlacementsByNodeResp, error) {
+	out := new(ListPlacementsByNodeResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListPlacementsByNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ListDrift(ctx context.Context, in *ListDriftReq, opts ...grpc.CallOption) (*ListDriftResp, error) {
+	out := new(ListDriftResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) DiffParamSets(ctx context.Context, in *DiffParamSetsReq, opts ...grpc.CallOption) (*DiffParamSetsResp, error) {
+	out := new(DiffParamSetsResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/DiffParamSets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ListPlacementStrategies(ctx context.Context, in *ListPlacementStrategiesReq, opts ...grpc.CallOption) (*ListPlacementStrategiesResp, error) {
+	out := new(ListPlacementStrategiesResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListPlacementStrategies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) GetRollout(ctx context.Context, in *RolloutId, opts ...grpc.CallOption) (*Rollout, error) {
+	out := new(Rollout)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/GetRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ListRollouts(ctx context.Context, in *ListRolloutsReq, opts ...grpc.CallOption) (*ListRolloutsResp, error) {
+	out := new(ListRolloutsResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListRollouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) PauseRollout(ctx context.Context, in *RolloutId, opts ...grpc.CallOption) (*Rollout, error) {
+	out := new(Rollout)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/PauseRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ResumeRollout(ctx context.Context, in *RolloutId, opts ...grpc.CallOption) (*Rollout, error) {
+	out := new(Rollout)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ResumeRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) PromoteRollout(ctx context.Context, in *PromoteRolloutReq, opts ...grpc.CallOption) (*Rollout, error) {
+	out := new(Rollout)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/PromoteRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) AbortRollout(ctx context.Context, in *RolloutId, opts ...grpc.CallOption) (*Rollout, error) {
+	out := new(Rollout)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/AbortRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) PutMaintenanceWindow(ctx context.Context, in *MaintenanceWindow, opts ...grpc.CallOption) (*MaintenanceWindow, error) {
+	out := new(MaintenanceWindow)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/PutMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) DeleteMaintenanceWindow(ctx context.Context, in *MaintenanceWindowId, opts ...grpc.CallOption) (*MaintenanceWindow, error) {
+	out := new(MaintenanceWindow)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/DeleteMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsReq, opts ...grpc.CallOption) (*ListMaintenanceWindowsResp, error) {
+	out := new(ListMaintenanceWindowsResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListMaintenanceWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ScheduleStandaloneConfigPlacement(ctx context.Context, in *SchedulePlacementReq, opts ...grpc.CallOption) (*ScheduledPlacement, error) {
+	out := new(ScheduledPlacement)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ScheduleStandaloneConfigPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ScheduleConfigGroupPlacement(ctx context.Context, in *SchedulePlacementReq, opts ...grpc.CallOption) (*ScheduledPlacement, error) {
+	out := new(ScheduledPlacement)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ScheduleConfigGroupPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ListScheduledPlacements(ctx context.Context, in *ListScheduledPlacementsReq, opts ...grpc.CallOption) (*ListScheduledPlacementsResp, error) {
+	out := new(ListScheduledPlacementsResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListScheduledPlacements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) CancelScheduledPlacement(ctx context.Context, in *ScheduledPlacementId, opts ...grpc.CallOption) (*ScheduledPlacement, error) {
+	out := new(ScheduledPlacement)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/CancelScheduledPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ListPersistentPlacements(ctx context.Context, in *ListPersistentPlacementsReq, opts ...grpc.CallOption) (*ListPersistentPlacementsResp, error) {
+	out := new(ListPersistentPlacementsResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListPersistentPlacements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) DeletePersistentPlacement(ctx context.Context, in *PersistentPlacementId, opts ...grpc.CallOption) (*PersistentPlacement, error) {
+	out := new(PersistentPlacement)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/DeletePersistentPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KuiperServer is the server API for Kuiper service.
+// All implementations must embed UnimplementedKuiperServer
+// for forward compatibility
+type KuiperServer interface {
+	PutStandaloneConfig(context.Context, *NewStandaloneConfig) (*StandaloneConfig, error)
+	GetStandaloneConfig(context.Context, *ConfigId) (*StandaloneConfig, error)
+	ListStandaloneConfig(context.Context, *ListStandaloneConfigReq) (*ListStandaloneConfigResp, error)
+	ListOrgStandaloneConfig(context.Context, *ListOrgConfigReq) (*ListStandaloneConfigResp, error)
+	SearchStandaloneConfig(context.Context, *SearchConfigReq) (*ListStandaloneConfigResp, error)
+	DeleteStandaloneConfig(context.Context, *ConfigId) (*StandaloneConfig, error)
+	PlaceStandaloneConfig(context.Context, *PlaceReq) (*PlaceResp, error)
+	ListPlacementTaskByStandaloneConfig(context.Context, *ListPlacementTaskReq) (*ListPlacementTaskResp, error)
+	WatchStandaloneConfigPlacement(*WatchPlacementReq, Kuiper_WatchStandaloneConfigPlacementServer) error
+	CancelStandaloneConfigPlacement(context.Context, *CancelPlacementReq) (*CancelPlacementResp, error)
+	RollbackStandaloneConfig(context.Context, *ConfigId) (*RollbackResp, error)
+	DiffStandaloneConfig(context.Context, *DiffReq) (*DiffStandaloneConfigResp, error)
+	MergeStandaloneConfig(context.Context, *MergeReq) (*MergeStandaloneConfigResp, error)
+	PatchStandaloneConfig(context.Context, *PatchReq) (*StandaloneConfig, error)
+	PutConfigGroup(context.Context, *NewConfigGroup) (*ConfigGroup, error)
+	GetConfigGroup(context.Context, *ConfigId) (*ConfigGroup, error)
+	ListConfigGroup(context.Context, *ListConfigGroupReq) (*ListConfigGroupResp, error)
+	ListOrgConfigGroup(context.Context, *ListOrgConfigReq) (*ListConfigGroupResp, error)
+	SearchConfigGroup(context.Context, *SearchConfigReq) (*ListConfigGroupResp, error)
+	DeleteConfigGroup(context.Context, *ConfigId) (*ConfigGroup, error)
+	PlaceConfigGroup(context.Context, *PlaceReq) (*PlaceResp, error)
+	ListPlacementTaskByConfigGroup(context.Context, *ListPlacementTaskReq) (*ListPlacementTaskResp, error)
+	WatchConfigGroupPlacement(*WatchPlacementReq, Kuiper_WatchConfigGroupPlacementServer) error
+	CancelConfigGroupPlacement(context.Context, *CancelPlacementReq) (*CancelPlacementResp, error)
+	RollbackConfigGroup(context.Context, *ConfigId) (*RollbackResp, error)
+	DiffConfigGroup(context.Context, *DiffReq) (*DiffConfigGroupResp, error)
+	MergeConfigGroup(context.Context, *MergeReq) (*MergeConfigGroupResp, error)
+	PatchConfigGroup(context.Context, *PatchReq) (*ConfigGroup, error)
+	ListPlacementsByNode(context.Context, *ListPlacementsByNodeReq) (*ListPlacementsByNodeResp, error)
+	ListDrift(context.Context, *ListDriftReq) (*ListDriftResp, error)
+	DiffParamSets(context.Context, *DiffParamSetsReq) (*DiffParamSetsResp, error)
+	ListPlacementStrategies(context.Context, *ListPlacementStrategiesReq) (*ListPlacementStrategiesResp, error)
+	GetRollout(context.Context, *RolloutId) (*Rollout, error)
+	ListRollouts(context.Context, *ListRolloutsReq) (*ListRolloutsResp, error)
+	PauseRollout(context.Context, *RolloutId) (*Rollout, error)
+	ResumeRollout(context.Context, *RolloutId) (*Rollout, error)
+	PromoteRollout(context.Context, *PromoteRolloutReq) (*Rollout, error)
+	AbortRollout(context.Context, *RolloutId) (*Rollout, error)
+	PutMaintenanceWindow(context.Context, *MaintenanceWindow) (*MaintenanceWindow, error)
+	DeleteMaintenanceWindow(context.Context, *MaintenanceWindowId) (*MaintenanceWindow, error)
+	ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsReq) (*ListMaintenanceWindowsResp, error)
+	ScheduleStandaloneConfigPlacement(context.Context, *SchedulePlacementReq) (*ScheduledPlacement, error)
+	ScheduleConfigGroupPlacement(context.Context, *SchedulePlacementReq) (*ScheduledPlacement, error)
+	ListScheduledPlacements(context.Context, *ListScheduledPlacementsReq) (*ListScheduledPlacementsResp, error)
+	CancelScheduledPlacement(context.Context, *ScheduledPlacementId) (*ScheduledPlacement, error)
+	ListPersistentPlacements(context.Context, *ListPersistentPlacementsReq) (*ListPersistentPlacementsResp, error)
+	DeletePersistentPlacement(context.Context, *PersistentPlacementId) (*PersistentPlacement, error)
+	mustEmbedUnimplementedKuiperServer()
+}
+
+// UnimplementedKuiperServer must be embedded to have forward compatible implementations.
+type UnimplementedKuiperServer struct {
+}
+
+func (UnimplementedKuiperServer) PutStandaloneConfig(context.Context, *NewStandaloneConfig) (*StandaloneConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) GetStandaloneConfig(context.Context, *ConfigId) (*StandaloneConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) ListStandaloneConfig(context.Context, *ListStandaloneConfigReq) (*ListStandaloneConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) ListOrgStandaloneConfig(context.Context, *ListOrgConfigReq) (*ListStandaloneConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrgStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) SearchStandaloneConfig(context.Context, *SearchConfigReq) (*ListStandaloneConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) DeleteStandaloneConfig(context.Context, *ConfigId) (*StandaloneConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) PlaceStandaloneConfig(context.Context, *PlaceReq) (*PlaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) ListPlacementTaskByStandaloneConfig(context.Context, *ListPlacementTaskReq) (*ListPlacementTaskResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlacementTaskByStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) WatchStandaloneConfigPlacement(*WatchPlacementReq, Kuiper_WatchStandaloneConfigPlacementServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStandaloneConfigPlacement not implemented")
+}
+func (UnimplementedKuiperServer) CancelStandaloneConfigPlacement(context.Context, *CancelPlacementReq) (*CancelPlacementResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStandaloneConfigPlacement not implemented")
+}
+func (UnimplementedKuiperServer) RollbackStandaloneConfig(context.Context, *ConfigId) (*RollbackResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) DiffStandaloneConfig(context.Context, *DiffReq) (*DiffStandaloneConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) MergeStandaloneConfig(context.Context, *MergeReq) (*MergeStandaloneConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) PatchStandaloneConfig(context.Context, *PatchReq) (*StandaloneConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) PutConfigGroup(context.Context, *NewConfigGroup) (*ConfigGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutConfigGroup not implemented")
+}
+func (UnimplementedKuiperServer) GetConfigGroup(context.Context, *ConfigId) (*ConfigGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigGroup not implemented")
+}
+func (UnimplementedKuiperServer) ListConfigGroup(context.Context, *ListConfigGroupReq) (*ListConfigGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigGroup not implemented")
+}
+func (UnimplementedKuiperServer) ListOrgConfigGroup(context.Context, *ListOrgConfigReq) (*ListConfigGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrgConfigGroup not implemented")
+}
+func (UnimplementedKuiperServer) SearchConfigGroup(context.Context, *SearchConfigReq) (*ListConfigGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchConfigGroup not implemented")
+}
+func (UnimplementedKuiperServer) DeleteConfigGroup(context.Context, *ConfigId) (*ConfigGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigGroup not implemented")
+}
+func (UnimplementedKuiperServer) PlaceConfigGroup(context.Context, *PlaceReq) (*PlaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceConfigGroup not implemented")
+}
+func (UnimplementedKuiperServer) ListPlacementTaskByConfigGroup(context.Context, *ListPlacementTaskReq) (*ListPlacementTaskResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlacementTaskByConfigGroup not implemented")
+}
+func (UnimplementedKuiperServer) WatchConfigGroupPlacement(*WatchPlacementReq, Kuiper_WatchConfigGroupPlacementServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConfigGroupPlacement not implemented")
+}
+func (UnimplementedKuiperServer) CancelConfigGroupPlacement(context.Context, *CancelPlacementReq) (*CancelPlacementResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelConfigGroupPlacement not implemented")
+}
+func (UnimplementedKuiperServer) RollbackConfigGroup(context.Context, *ConfigId) (*RollbackResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackConfigGroup not implemented")
+}
+func (UnimplementedKuiperServer) DiffConfigGroup(context.Context, *DiffReq) (*DiffConfigGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffConfigGroup not implemented")
+}
+func (UnimplementedKuiperServer) MergeConfigGroup(context.Context, *MergeReq) (*MergeConfigGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeConfigGroup not implemented")
+}
+func (UnimplementedKuiperServer) PatchConfigGroup(context.Context, *PatchReq) (*ConfigGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchConfigGroup not implemented")
+}
+func (UnimplementedKuiperServer) ListPlacementsByNode(context.Context, *ListPlacementsByNodeReq) (*ListPlacementsByNodeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlacementsByNode not implemented")
+}
+func (UnimplementedKuiperServer) ListDrift(context.Context, *ListDriftReq) (*ListDriftResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrift not implemented")
+}
+func (UnimplementedKuiperServer) DiffParamSets(context.Context, *DiffParamSetsReq) (*DiffParamSetsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffParamSets not implemented")
+}
+func (UnimplementedKuiperServer) ListPlacementStrategies(context.Context, *ListPlacementStrategiesReq) (*ListPlacementStrategiesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlacementStrategies not implemented")
+}
+func (UnimplementedKuiperServer) GetRollout(context.Context, *RolloutId) (*Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRollout not implemented")
+}
+func (UnimplementedKuiperServer) ListRollouts(context.Context, *ListRolloutsReq) (*ListRolloutsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRollouts not implemented")
+}
+func (UnimplementedKuiperServer) PauseRollout(context.Context, *RolloutId) (*Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRollout not implemented")
+}
+func (UnimplementedKuiperServer) ResumeRollout(context.Context, *RolloutId) (*Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRollout not implemented")
+}
+func (UnimplementedKuiperServer) PromoteRollout(context.Context, *PromoteRolloutReq) (*Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteRollout not implemented")
+}
+func (UnimplementedKuiperServer) AbortRollout(context.Context, *RolloutId) (*Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortRollout not implemented")
+}
+func (UnimplementedKuiperServer) PutMaintenanceWindow(context.Context, *MaintenanceWindow) (*MaintenanceWindow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutMaintenanceWindow not implemented")
+}
+func (UnimplementedKuiperServer) DeleteMaintenanceWindow(context.Context, *MaintenanceWindowId) (*MaintenanceWindow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMaintenanceWindow not implemented")
+}
+func (UnimplementedKuiperServer) ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsReq) (*ListMaintenanceWindowsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaintenanceWindows not implemented")
+}
+func (UnimplementedKuiperServer) ScheduleStandaloneConfigPlacement(context.Context, *SchedulePlacementReq) (*ScheduledPlacement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleStandaloneConfigPlacement not implemented")
+}
+func (UnimplementedKuiperServer) ScheduleConfigGroupPlacement(context.Context, *SchedulePlacementReq) (*ScheduledPlacement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleConfigGroupPlacement not implemented")
+}
+func (UnimplementedKuiperServer) ListScheduledPlacements(context.Context, *ListScheduledPlacementsReq) (*ListScheduledPlacementsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledPlacements not implemented")
+}
+func (UnimplementedKuiperServer) CancelScheduledPlacement(context.Context, *ScheduledPlacementId) (*ScheduledPlacement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledPlacement not implemented")
+}
+func (UnimplementedKuiperServer) ListPersistentPlacements(context.Context, *ListPersistentPlacementsReq) (*ListPersistentPlacementsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersistentPlacements not implemented")
+}
+func (UnimplementedKuiperServer) DeletePersistentPlacement(context.Context, *PersistentPlacementId) (*PersistentPlacement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePersistentPlacement not implemented")
+}
+func (UnimplementedKuiperServer) mustEmbedUnimplementedKuiperServer() {}
+
+// UnsafeKuiperServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KuiperServer will
+// result in compilation errors.
+type UnsafeKuiperServer interface {
+	mustEmbedUnimplementedKuiperServer()
+}
+
+func RegisterKuiperServer(s grpc.ServiceRegistrar, srv KuiperServer) {
+	s.RegisterService(&Kuiper_ServiceDesc, srv)
+}
+
+func _Kuiper_PutStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewStandaloneConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).PutStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/PutStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).PutStandaloneConfig(ctx, req.(*NewStandaloneConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_GetStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).GetStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/GetStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).GetStandaloneConfig(ctx, req.(*ConfigId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_ListStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStandaloneConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ListStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ListStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ListStandaloneConfig(ctx, req.(*ListStandaloneConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_ListOrgStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrgConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ListOrgStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ListOrgStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ListOrgStandaloneConfig(ctx, req.(*ListOrgConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_SearchStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).SearchStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/SearchStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).SearchStandaloneConfig(ctx, req.(*SearchConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_DeleteStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).DeleteStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/DeleteStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).DeleteStandaloneConfig(ctx, req.(*ConfigId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_PlaceStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).PlaceStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/PlaceStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).PlaceStandaloneConfig(ctx, req.(*PlaceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_ListPlacementTaskByStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlacementTaskReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ListPlacementTaskByStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ListPlacementTaskByStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ListPlacementTaskByStandaloneConfig(ctx, req.(*ListPlacementTaskReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_WatchStandaloneConfigPlacement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPlacementReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KuiperServer).WatchStandaloneConfigPlacement(m, &kuiperWatchStandaloneConfigPlacementServer{stream})
+}
+
+type Kuiper_WatchStandaloneConfigPlacementServer interface {
+	Send(*PlacementEvent) error
+	grpc.ServerStream
+}
+
+type kuiperWatchStandaloneConfigPlacementServer struct {
+	grpc.ServerStream
+}
+
+func (x *kuiperWatchStandaloneConfigPlacementServer) Send(m *PlacementEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Kuiper_CancelStandaloneConfigPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPlacementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).CancelStandaloneConfigPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/CancelStandaloneConfigPlacement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).CancelStandaloneConfigPlacement(ctx, req.(*CancelPlacementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_RollbackStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).RollbackStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/RollbackStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).RollbackStandaloneConfig(ctx, req.(*ConfigId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_DiffStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).DiffStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/DiffStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).DiffStandaloneConfig(ctx, req.(*DiffReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_MergeStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).MergeStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/MergeStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).MergeStandaloneConfig(ctx, req.(*MergeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_PatchStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).PatchStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/PatchStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).PatchStandaloneConfig(ctx, req.(*PatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_PutConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewConfigGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).PutConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/PutConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).PutConfigGroup(ctx, req.(*NewConfigGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_GetConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).GetConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/GetConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).GetConfigGroup(ctx, req.(*ConfigId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_ListConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ListConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ListConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ListConfigGroup(ctx, req.(*ListConfigGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_ListOrgConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrgConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ListOrgConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ListOrgConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ListOrgConfigGroup(ctx, req.(*ListOrgConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_SearchConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).SearchConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/SearchConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).SearchConfigGroup(ctx, req.(*SearchConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_DeleteConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).DeleteConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/DeleteConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).DeleteConfigGroup(ctx, req.(*ConfigId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_PlaceConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).PlaceConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/PlaceConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).PlaceConfigGroup(ctx, req.(*PlaceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_ListPlacementTaskByConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlacementTaskReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ListPlacementTaskByConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ListPlacementTaskByConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ListPlacementTaskByConfigGroup(ctx, req.(*ListPlacementTaskReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_WatchConfigGroupPlacement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPlacementReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KuiperServer).WatchConfigGroupPlacement(m, &kuiperWatchConfigGroupPlacementServer{stream})
+}
+
+type Kuiper_WatchConfigGroupPlacementServer interface {
+	Send(*PlacementEvent) error
+	grpc.ServerStream
+}
+
+type kuiperWatchConfigGroupPlacementServer struct {
+	grpc.ServerStream
+}
+
+func (x *kuiperWatchConfigGroupPlacementServer) Send(m *PlacementEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Kuiper_CancelConfigGroupPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPlacementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).CancelConfigGroupPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/CancelConfigGroupPlacement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).CancelConfigGroupPlacement(ctx, req.(*CancelPlacementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_RollbackConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).RollbackConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/RollbackConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).RollbackConfigGroup(ctx, req.(*ConfigId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_DiffConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).DiffConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/DiffConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).DiffConfigGroup(ctx, req.(*DiffReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_MergeConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).MergeConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/MergeConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).MergeConfigGroup(ctx, req.(*MergeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_PatchConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).PatchConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/PatchConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).PatchConfigGroup(ctx, req.(*PatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_ListPlacementsByNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlacementsByNodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ListPlacementsByNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ListPlacementsByNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ListPlacementsByNode(ctx, req.(*ListPlacementsByNodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_ListDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDriftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ListDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ListDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ListDrift(ctx, req.(*ListDriftReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *kuiperClient) PlaceConfigGroup(ctx context.Context, in *PlaceReq, opts ...grpc.CallOption) (*PlaceResp, error) {
-	out := new(PlaceResp)
-	err := c.cc.Invoke(ctx, "/proto.Kuiper/PlaceConfigGroup", in, out, opts...)
-	if err != nil {
+func _Kuiper_DiffParamSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffParamSetsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(KuiperServer).DiffParamSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/DiffParamSets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).DiffParamSets(ctx, req.(*DiffParamSetsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *kuiperClient) ListPlacementTaskByConfigGroup(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*ListPlacementTaskResp, error) {
-	out := new(ListPlacementTaskResp)
-	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListPlacementTaskByConfigGroup", in, out, opts...)
-	if err != nil {
+func _Kuiper_ListPlacementStrategies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlacementStrategiesReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(KuiperServer).ListPlacementStrategies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ListPlacementStrategies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ListPlacementStrategies(ctx, req.(*ListPlacementStrategiesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *kuiperClient) DiffConfigGroup(ctx context.Context, in *DiffReq, opts ...grpc.CallOption) (*DiffConfigGroupResp, error) {
-	out := new(DiffConfigGroupResp)
-	err := c.cc.Invoke(ctx, "/proto.Kuiper/DiffConfigGroup", in, out, opts...)
-	if err != nil {
+func _Kuiper_GetRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutId)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// KuiperServer is the server API for Kuiper service.
-// All implementations must embed UnimplementedKuiperServer
-// for forward compatibility
-type KuiperServer interface {
-	PutStandaloneConfig(context.Context, *NewStandaloneConfig) (*StandaloneConfig, error)
-	GetStandaloneConfig(context.Context, *ConfigId) (*StandaloneConfig, error)
-	ListStandaloneConfig(context.Context, *ListStandaloneConfigReq) (*ListStandaloneConfigResp, error)
-	DeleteStandaloneConfig(context.Context, *ConfigId) (*StandaloneConfig, error)
-	PlaceStandaloneConfig(context.Context, *PlaceReq) (*PlaceResp, error)
-	ListPlacementTaskByStandaloneConfig(context.Context, *ConfigId) (*ListPlacementTaskResp, error)
-	DiffStandaloneConfig(context.Context, *DiffReq) (*DiffStandaloneConfigResp, error)
-	PutConfigGroup(context.Context, *NewConfigGroup) (*ConfigGroup, error)
-	GetConfigGroup(context.Context, *ConfigId) (*ConfigGroup, error)
-	ListConfigGroup(context.Context, *ListConfigGroupReq) (*ListConfigGroupResp, error)
-	DeleteConfigGroup(context.Context, *ConfigId) (*ConfigGroup, error)
-	PlaceConfigGroup(context.Context, *PlaceReq) (*PlaceResp, error)
-	ListPlacementTaskByConfigGroup(context.Context, *ConfigId) (*ListPlacementTaskResp, error)
-	DiffConfigGroup(context.Context, *DiffReq) (*DiffConfigGroupResp, error)
-	mustEmbedUnimplementedKuiperServer()
-}
-
-// UnimplementedKuiperServer must be embedded to have forward compatible implementations.
-type UnimplementedKuiperServer struct {
-}
-
-func (UnimplementedKuiperServer) PutStandaloneConfig(context.Context, *NewStandaloneConfig) (*StandaloneConfig, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PutStandaloneConfig not implemented")
-}
-func (UnimplementedKuiperServer) GetStandaloneConfig(context.Context, *ConfigId) (*StandaloneConfig, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStandaloneConfig not implemented")
-}
-func (UnimplementedKuiperServer) ListStandaloneConfig(context.Context, *ListStandaloneConfigReq) (*ListStandaloneConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListStandaloneConfig not implemented")
-}
-func (UnimplementedKuiperServer) DeleteStandaloneConfig(context.Context, *ConfigId) (*StandaloneConfig, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteStandaloneConfig not implemented")
-}
-func (UnimplementedKuiperServer) PlaceStandaloneConfig(context.Context, *PlaceReq) (*PlaceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceStandaloneConfig not implemented")
-}
-func (UnimplementedKuiperServer) ListPlacementTaskByStandaloneConfig(context.Context, *ConfigId) (*ListPlacementTaskResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPlacementTaskByStandaloneConfig not implemented")
-}
-func (UnimplementedKuiperServer) DiffStandaloneConfig(context.Context, *DiffReq) (*DiffStandaloneConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DiffStandaloneConfig not implemented")
-}
-func (UnimplementedKuiperServer) PutConfigGroup(context.Context, *NewConfigGroup) (*ConfigGroup, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PutConfigGroup not implemented")
-}
-func (UnimplementedKuiperServer) GetConfigGroup(context.Context, *ConfigId) (*ConfigGroup, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigGroup not implemented")
-}
-func (UnimplementedKuiperServer) ListConfigGroup(context.Context, *ListConfigGroupReq) (*ListConfigGroupResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListConfigGroup not implemented")
-}
-func (UnimplementedKuiperServer) DeleteConfigGroup(context.Context, *ConfigId) (*ConfigGroup, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigGroup not implemented")
-}
-func (UnimplementedKuiperServer) PlaceConfigGroup(context.Context, *PlaceReq) (*PlaceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceConfigGroup not implemented")
-}
-func (UnimplementedKuiperServer) ListPlacementTaskByConfigGroup(context.Context, *ConfigId) (*ListPlacementTaskResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPlacementTaskByConfigGroup not implemented")
-}
-func (UnimplementedKuiperServer) DiffConfigGroup(context.Context, *DiffReq) (*DiffConfigGroupResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DiffConfigGroup not implemented")
-}
-func (UnimplementedKuiperServer) mustEmbedUnimplementedKuiperServer() {}
-
-// UnsafeKuiperServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to KuiperServer will
-// result in compilation errors.
-type UnsafeKuiperServer interface {
-	mustEmbedUnimplementedKuiperServer()
-}
-
-func RegisterKuiperServer(s grpc.ServiceRegistrar, srv KuiperServer) {
-	s.RegisterService(&Kuiper_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(KuiperServer).GetRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/GetRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).GetRollout(ctx, req.(*RolloutId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_PutStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewStandaloneConfig)
+func _Kuiper_ListRollouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolloutsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).PutStandaloneConfig(ctx, in)
+		return srv.(KuiperServer).ListRollouts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/PutStandaloneConfig",
+		FullMethod: "/proto.Kuiper/ListRollouts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).PutStandaloneConfig(ctx, req.(*NewStandaloneConfig))
+		return srv.(KuiperServer).ListRollouts(ctx, req.(*ListRolloutsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_GetStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigId)
+func _Kuiper_PauseRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).GetStandaloneConfig(ctx, in)
+		return srv.(KuiperServer).PauseRollout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/GetStandaloneConfig",
+		FullMethod: "/proto.Kuiper/PauseRollout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).GetStandaloneConfig(ctx, req.(*ConfigId))
+		return srv.(KuiperServer).PauseRollout(ctx, req.(*RolloutId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_ListStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListStandaloneConfigReq)
+func _Kuiper_ResumeRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).ListStandaloneConfig(ctx, in)
+		return srv.(KuiperServer).ResumeRollout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/ListStandaloneConfig",
+		FullMethod: "/proto.Kuiper/ResumeRollout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).ListStandaloneConfig(ctx, req.(*ListStandaloneConfigReq))
+		return srv.(KuiperServer).ResumeRollout(ctx, req.(*RolloutId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_DeleteStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigId)
+func _Kuiper_PromoteRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteRolloutReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).DeleteStandaloneConfig(ctx, in)
+		return srv.(KuiperServer).PromoteRollout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/DeleteStandaloneConfig",
+		FullMethod: "/proto.Kuiper/PromoteRollout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).DeleteStandaloneConfig(ctx, req.(*ConfigId))
+		return srv.(KuiperServer).PromoteRollout(ctx, req.(*PromoteRolloutReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_PlaceStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PlaceReq)
+func _Kuiper_AbortRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).PlaceStandaloneConfig(ctx, in)
+		return srv.(KuiperServer).AbortRollout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/PlaceStandaloneConfig",
+		FullMethod: "/proto.Kuiper/AbortRollout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).PlaceStandaloneConfig(ctx, req.(*PlaceReq))
+		return srv.(KuiperServer).AbortRollout(ctx, req.(*RolloutId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_ListPlacementTaskByStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigId)
+func _Kuiper_PutMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceWindow)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).ListPlacementTaskByStandaloneConfig(ctx, in)
+		return srv.(KuiperServer).PutMaintenanceWindow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/ListPlacementTaskByStandaloneConfig",
+		FullMethod: "/proto.Kuiper/PutMaintenanceWindow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).ListPlacementTaskByStandaloneConfig(ctx, req.(*ConfigId))
+		return srv.(KuiperServer).PutMaintenanceWindow(ctx, req.(*MaintenanceWindow))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_DiffStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiffReq)
+func _Kuiper_DeleteMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceWindowId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).DiffStandaloneConfig(ctx, in)
+		return srv.(KuiperServer).DeleteMaintenanceWindow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/DiffStandaloneConfig",
+		FullMethod: "/proto.Kuiper/DeleteMaintenanceWindow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).DiffStandaloneConfig(ctx, req.(*DiffReq))
+		return srv.(KuiperServer).DeleteMaintenanceWindow(ctx, req.(*MaintenanceWindowId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_PutConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewConfigGroup)
+func _Kuiper_ListMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceWindowsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).PutConfigGroup(ctx, in)
+		return srv.(KuiperServer).ListMaintenanceWindows(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/PutConfigGroup",
+		FullMethod: "/proto.Kuiper/ListMaintenanceWindows",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).PutConfigGroup(ctx, req.(*NewConfigGroup))
+		return srv.(KuiperServer).ListMaintenanceWindows(ctx, req.(*ListMaintenanceWindowsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_GetConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigId)
+func _Kuiper_ScheduleStandaloneConfigPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePlacementReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).GetConfigGroup(ctx, in)
+		return srv.(KuiperServer).ScheduleStandaloneConfigPlacement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/GetConfigGroup",
+		FullMethod: "/proto.Kuiper/ScheduleStandaloneConfigPlacement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).GetConfigGroup(ctx, req.(*ConfigId))
+		return srv.(KuiperServer).ScheduleStandaloneConfigPlacement(ctx, req.(*SchedulePlacementReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_ListConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigGroupReq)
+func _Kuiper_ScheduleConfigGroupPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePlacementReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).ListConfigGroup(ctx, in)
+		return srv.(KuiperServer).ScheduleConfigGroupPlacement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/ListConfigGroup",
+		FullMethod: "/proto.Kuiper/ScheduleConfigGroupPlacement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).ListConfigGroup(ctx, req.(*ListConfigGroupReq))
+		return srv.(KuiperServer).ScheduleConfigGroupPlacement(ctx, req.(*SchedulePlacementReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_DeleteConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigId)
+func _Kuiper_ListScheduledPlacements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledPlacementsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).DeleteConfigGroup(ctx, in)
+		return srv.(KuiperServer).ListScheduledPlacements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/DeleteConfigGroup",
+		FullMethod: "/proto.Kuiper/ListScheduledPlacements",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).DeleteConfigGroup(ctx, req.(*ConfigId))
+		return srv.(KuiperServer).ListScheduledPlacements(ctx, req.(*ListScheduledPlacementsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_PlaceConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PlaceReq)
+func _Kuiper_CancelScheduledPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledPlacementId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).PlaceConfigGroup(ctx, in)
+		return srv.(KuiperServer).CancelScheduledPlacement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/PlaceConfigGroup",
+		FullMethod: "/proto.Kuiper/CancelScheduledPlacement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).PlaceConfigGroup(ctx, req.(*PlaceReq))
+		return srv.(KuiperServer).CancelScheduledPlacement(ctx, req.(*ScheduledPlacementId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_ListPlacementTaskByConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigId)
+func _Kuiper_ListPersistentPlacements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersistentPlacementsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).ListPlacementTaskByConfigGroup(ctx, in)
+		return srv.(KuiperServer).ListPersistentPlacements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/ListPlacementTaskByConfigGroup",
+		FullMethod: "/proto.Kuiper/ListPersistentPlacements",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).ListPlacementTaskByConfigGroup(ctx, req.(*ConfigId))
+		return srv.(KuiperServer).ListPersistentPlacements(ctx, req.(*ListPersistentPlacementsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Kuiper_DiffConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiffReq)
+func _Kuiper_DeletePersistentPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistentPlacementId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KuiperServer).DiffConfigGroup(ctx, in)
+		return srv.(KuiperServer).DeletePersistentPlacement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Kuiper/DiffConfigGroup",
+		FullMethod: "/proto.Kuiper/DeletePersistentPlacement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KuiperServer).DiffConfigGroup(ctx, req.(*DiffReq))
+		return srv.(KuiperServer).DeletePersistentPlacement(ctx, req.(*PersistentPlacementId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -523,6 +1631,14 @@ var Kuiper_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListStandaloneConfig",
 			Handler:    _Kuiper_ListStandaloneConfig_Handler,
 		},
+		{
+			MethodName: "ListOrgStandaloneConfig",
+			Handler:    _Kuiper_ListOrgStandaloneConfig_Handler,
+		},
+		{
+			MethodName: "SearchStandaloneConfig",
+			Handler:    _Kuiper_SearchStandaloneConfig_Handler,
+		},
 		{
 			MethodName: "DeleteStandaloneConfig",
 			Handler:    _Kuiper_DeleteStandaloneConfig_Handler,
@@ -535,10 +1651,26 @@ var Kuiper_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPlacementTaskByStandaloneConfig",
 			Handler:    _Kuiper_ListPlacementTaskByStandaloneConfig_Handler,
 		},
+		{
+			MethodName: "CancelStandaloneConfigPlacement",
+			Handler:    _Kuiper_CancelStandaloneConfigPlacement_Handler,
+		},
+		{
+			MethodName: "RollbackStandaloneConfig",
+			Handler:    _Kuiper_RollbackStandaloneConfig_Handler,
+		},
 		{
 			MethodName: "DiffStandaloneConfig",
 			Handler:    _Kuiper_DiffStandaloneConfig_Handler,
 		},
+		{
+			MethodName: "MergeStandaloneConfig",
+			Handler:    _Kuiper_MergeStandaloneConfig_Handler,
+		},
+		{
+			MethodName: "PatchStandaloneConfig",
+			Handler:    _Kuiper_PatchStandaloneConfig_Handler,
+		},
 		{
 			MethodName: "PutConfigGroup",
 			Handler:    _Kuiper_PutConfigGroup_Handler,
@@ -551,6 +1683,14 @@ var Kuiper_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListConfigGroup",
 			Handler:    _Kuiper_ListConfigGroup_Handler,
 		},
+		{
+			MethodName: "ListOrgConfigGroup",
+			Handler:    _Kuiper_ListOrgConfigGroup_Handler,
+		},
+		{
+			MethodName: "SearchConfigGroup",
+			Handler:    _Kuiper_SearchConfigGroup_Handler,
+		},
 		{
 			MethodName: "DeleteConfigGroup",
 			Handler:    _Kuiper_DeleteConfigGroup_Handler,
@@ -563,11 +1703,114 @@ var Kuiper_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPlacementTaskByConfigGroup",
 			Handler:    _Kuiper_ListPlacementTaskByConfigGroup_Handler,
 		},
+		{
+			MethodName: "CancelConfigGroupPlacement",
+			Handler:    _Kuiper_CancelConfigGroupPlacement_Handler,
+		},
+		{
+			MethodName: "RollbackConfigGroup",
+			Handler:    _Kuiper_RollbackConfigGroup_Handler,
+		},
 		{
 			MethodName: "DiffConfigGroup",
 			Handler:    _Kuiper_DiffConfigGroup_Handler,
 		},
+		{
+			MethodName: "MergeConfigGroup",
+			Handler:    _Kuiper_MergeConfigGroup_Handler,
+		},
+		{
+			MethodName: "PatchConfigGroup",
+			Handler:    _Kuiper_PatchConfigGroup_Handler,
+		},
+		{
+			MethodName: "ListPlacementsByNode",
+			Handler:    _Kuiper_ListPlacementsByNode_Handler,
+		},
+		{
+			MethodName: "ListDrift",
+			Handler:    _Kuiper_ListDrift_Handler,
+		},
+		{
+			MethodName: "DiffParamSets",
+			Handler:    _Kuiper_DiffParamSets_Handler,
+		},
+		{
+			MethodName: "ListPlacementStrategies",
+			Handler:    _Kuiper_ListPlacementStrategies_Handler,
+		},
+		{
+			MethodName: "GetRollout",
+			Handler:    _Kuiper_GetRollout_Handler,
+		},
+		{
+			MethodName: "ListRollouts",
+			Handler:    _Kuiper_ListRollouts_Handler,
+		},
+		{
+			MethodName: "PauseRollout",
+			Handler:    _Kuiper_PauseRollout_Handler,
+		},
+		{
+			MethodName: "ResumeRollout",
+			Handler:    _Kuiper_ResumeRollout_Handler,
+		},
+		{
+			MethodName: "PromoteRollout",
+			Handler:    _Kuiper_PromoteRollout_Handler,
+		},
+		{
+			MethodName: "AbortRollout",
+			Handler:    _Kuiper_AbortRollout_Handler,
+		},
+		{
+			MethodName: "PutMaintenanceWindow",
+			Handler:    _Kuiper_PutMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "DeleteMaintenanceWindow",
+			Handler:    _Kuiper_DeleteMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "ListMaintenanceWindows",
+			Handler:    _Kuiper_ListMaintenanceWindows_Handler,
+		},
+		{
+			MethodName: "ScheduleStandaloneConfigPlacement",
+			Handler:    _Kuiper_ScheduleStandaloneConfigPlacement_Handler,
+		},
+		{
+			MethodName: "ScheduleConfigGroupPlacement",
+			Handler:    _Kuiper_ScheduleConfigGroupPlacement_Handler,
+		},
+		{
+			MethodName: "ListScheduledPlacements",
+			Handler:    _Kuiper_ListScheduledPlacements_Handler,
+		},
+		{
+			MethodName: "CancelScheduledPlacement",
+			Handler:    _Kuiper_CancelScheduledPlacement_Handler,
+		},
+		{
+			MethodName: "ListPersistentPlacements",
+			Handler:    _Kuiper_ListPersistentPlacements_Handler,
+		},
+		{
+			MethodName: "DeletePersistentPlacement",
+			Handler:    _Kuiper_DeletePersistentPlacement_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStandaloneConfigPlacement",
+			Handler:       _Kuiper_WatchStandaloneConfigPlacement_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchConfigGroupPlacement",
+			Handler:       _Kuiper_WatchConfigGroupPlacement_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "kuiper.proto",
 }
@@ -0,0 +1,55 @@
+package api
+
+import "github.com/c12s/kuiper/pkg/confighash"
+
+// ComputeContentHash hashes the params the same way Kuiper does when it
+// populates ContentHash, so agents can report what they actually run.
+func (x *StandaloneConfig) ComputeContentHash() string {
+	params := make(map[string]string, len(x.ParamSet))
+	for _, param := range x.ParamSet {
+		params[param.Key] = param.Value
+	}
+	return confighash.Params(params)
+}
+
+func (x *ConfigGroup) ComputeContentHash() string {
+	paramSets := make(map[string]map[string]string, len(x.ParamSets))
+	for _, paramSet := range x.ParamSets {
+		params := make(map[string]string, len(paramSet.ParamSet))
+		for _, param := range paramSet.ParamSet {
+			params[param.Key] = param.Value
+		}
+		paramSets[paramSet.Name] = params
+	}
+	return confighash.ParamSets(paramSets)
+}
+
+// NewAppliedStandaloneConfig describes a standalone config the node runs,
+// hashing the params as they are on the node.
+func NewAppliedStandaloneConfig(config *StandaloneConfig, taskId string) *AppliedConfig {
+	return &AppliedConfig{
+		Type: "standalone",
+		Config: &ConfigId{
+			Organization: config.Organization,
+			Name:         config.Name,
+			Version:      config.Version,
+			Namespace:    config.Namespace,
+		},
+		ContentHash: config.ComputeContentHash(),
+		TaskId:      taskId,
+	}
+}
+
+func NewAppliedConfigGroup(config *ConfigGroup, taskId string) *AppliedConfig {
+	return &AppliedConfig{
+		Type: "group",
+		Config: &ConfigId{
+			Organization: config.Organization,
+			Name:         config.Name,
+			Version:      config.Version,
+			Namespace:    config.Namespace,
+		},
+		ContentHash: config.ComputeContentHash(),
+		TaskId:      taskId,
+	}
+}
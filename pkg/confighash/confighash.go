@@ -0,0 +1,56 @@
+package confighash
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"slices"
+)
+
+type paramSet struct {
+	Name   string
+	Params [][2]string
+}
+
+// Params returns a digest of a param set that doesn't depend on map ordering.
+func Params(params map[string]string) string {
+	return hashJSON(sortedParams(params))
+}
+
+// ParamSets returns a digest of the named param sets of a config group.
+func ParamSets(paramSets map[string]map[string]string) string {
+	names := make([]string, 0, len(paramSets))
+	for name := range paramSets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	sorted := make([]paramSet, 0, len(names))
+	for _, name := range names {
+		sorted = append(sorted, paramSet{Name: name, Params: sortedParams(paramSets[name])})
+	}
+	return hashJSON(sorted)
+}
+
+func sortedParams(params map[string]string) [][2]string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	sorted := make([][2]string, 0, len(keys))
+	for _, key := range keys {
+		sorted = append(sorted, [2]string{key, params[key]})
+	}
+	return sorted
+}
+
+func hashJSON(value any) string {
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		log.Println(err)
+		return ""
+	}
+	sum := sha256.Sum256(jsonBytes)
+	return hex.EncodeToString(sum[:])
+}